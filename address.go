@@ -0,0 +1,226 @@
+package fees
+
+import "fmt"
+
+// AddressType identifies the kind of a Cardano address. Each type has a
+// canonical serialized length, which is what the minUTxO and fee estimators
+// need; see AddressBytesForType.
+type AddressType uint8
+
+const (
+	// AddressBase is a Shelley base address: header byte, 28-byte payment
+	// credential, and 28-byte stake credential. 57 bytes.
+	AddressBase AddressType = iota
+
+	// AddressPointer is a Shelley pointer address: header byte, 28-byte
+	// payment credential, and a variable-length pointer to a stake
+	// registration certificate. Typically 35 bytes.
+	AddressPointer
+
+	// AddressEnterprise is a Shelley enterprise address with no stake
+	// credential: header byte and 28-byte payment credential. 29 bytes.
+	AddressEnterprise
+
+	// AddressReward is a stake (reward account) address: header byte and
+	// 28-byte stake credential. 29 bytes.
+	AddressReward
+
+	// AddressByron is a legacy Byron bootstrap address. Sized at the
+	// ByronAddressBytes upper bound.
+	AddressByron
+)
+
+// String returns the address type's name, e.g. "base" or "enterprise".
+func (t AddressType) String() string {
+	switch t {
+	case AddressBase:
+		return "base"
+	case AddressPointer:
+		return "pointer"
+	case AddressEnterprise:
+		return "enterprise"
+	case AddressReward:
+		return "reward"
+	case AddressByron:
+		return "byron"
+	default:
+		return fmt.Sprintf("AddressType(%d)", uint8(t))
+	}
+}
+
+// AddressBytesForType returns the canonical serialized byte length of an
+// address of type t. Returns an error for unknown address types.
+//
+// Example:
+//
+//	n, err := fees.AddressBytesForType(fees.AddressEnterprise) // 29
+func AddressBytesForType(t AddressType) (uint64, error) {
+	switch t {
+	case AddressBase:
+		return 57, nil
+	case AddressPointer:
+		return 35, nil
+	case AddressEnterprise, AddressReward:
+		return 29, nil
+	case AddressByron:
+		return ByronAddressBytes, nil
+	default:
+		return 0, newMinUTxOError(ErrCodeUnknownAddressType, ErrUnknownAddressType, fmt.Sprintf("unknown address type %d", uint8(t)))
+	}
+}
+
+// AddressTypeFromHeaderByte returns the type of an address from its first
+// byte. The top 4 bits of the header select the type (CIP-19):
+//
+//	0000–0011  base
+//	0100–0101  pointer
+//	0110–0111  enterprise
+//	1000       byron
+//	1110–1111  reward
+//
+// Returns a *MinUTxOError with ErrCodeUnknownAddressType for the reserved
+// values 1001–1101.
+//
+// Example:
+//
+//	t, err := fees.AddressTypeFromHeaderByte(0x61) // AddressEnterprise
+func AddressTypeFromHeaderByte(header byte) (AddressType, error) {
+	switch header >> 4 {
+	case 0, 1, 2, 3:
+		return AddressBase, nil
+	case 4, 5:
+		return AddressPointer, nil
+	case 6, 7:
+		return AddressEnterprise, nil
+	case 8:
+		return AddressByron, nil
+	case 14, 15:
+		return AddressReward, nil
+	default:
+		return 0, newMinUTxOError(ErrCodeUnknownAddressType, ErrUnknownAddressType, fmt.Sprintf("unknown address header type %04b", header>>4))
+	}
+}
+
+// AddressBytesFromHeader returns the type of the raw address bytes, read
+// from the header byte, and their actual length, for sizing outputs parsed
+// from a CBOR transaction:
+//
+//	t, n, err := fees.AddressBytesFromHeader(raw)
+//	size := fees.EstimateOutputBytes(fees.OutputSize{AddressBytes: n})
+//
+// Returns a *MinUTxOError with ErrCodeZeroBytes if raw is empty, or as
+// AddressTypeFromHeaderByte for an unknown header.
+func AddressBytesFromHeader(raw []byte) (AddressType, uint64, error) {
+	if len(raw) == 0 {
+		return 0, 0, newMinUTxOError(ErrCodeZeroBytes, ErrZeroBytes, "address must not be empty")
+	}
+	t, err := AddressTypeFromHeaderByte(raw[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	return t, uint64(len(raw)), nil
+}
+
+// NewOutputSizeForNFT returns the OutputSize of an output to an address of
+// type addrType holding one NFT: one policy and one asset whose name is
+// assetNameBytes long.
+//
+// Returns a *MinUTxOError if addrType is unknown or assetNameBytes exceeds
+// 32.
+//
+// Example:
+//
+//	out, err := fees.NewOutputSizeForNFT(fees.AddressBase, 8)
+//	minADA, err := fees.MinUTxO(p, out)
+func NewOutputSizeForNFT(addrType AddressType, assetNameBytes uint64) (OutputSize, error) {
+	if !IsValidAssetNameLength(assetNameBytes) {
+		return OutputSize{}, newMinUTxOError(ErrCodeAssetNameTooLong, ErrAssetNameTooLong, fmt.Sprintf("assetNameBytes %d exceeds maximum of 32 bytes", assetNameBytes))
+	}
+	return NewOutputSizeForFT(addrType, 1, 1, assetNameBytes)
+}
+
+// NewOutputSizeForFT returns the OutputSize of an output to an address of
+// type addrType holding a fungible token bundle of numAssets assets across
+// numPolicies policies, with asset names totalling totalNameBytes.
+//
+// Returns a *MinUTxOError if addrType is unknown or the bundle is empty,
+// or a *ValidationError if the counts are inconsistent (see
+// OutputSize.Validate).
+//
+// Example:
+//
+//	out, err := fees.NewOutputSizeForFT(fees.AddressEnterprise, 1, 3, 24)
+func NewOutputSizeForFT(addrType AddressType, numPolicies, numAssets, totalNameBytes uint64) (OutputSize, error) {
+	addrBytes, err := AddressBytesForType(addrType)
+	if err != nil {
+		return OutputSize{}, err
+	}
+	if numAssets == 0 {
+		return OutputSize{}, newMinUTxOError(ErrCodeEmptyBundle, ErrEmptyBundle, "numAssets must be at least 1")
+	}
+	out := OutputSize{
+		AddressBytes:        addrBytes,
+		NumPolicies:         numPolicies,
+		NumAssets:           numAssets,
+		TotalAssetNameBytes: totalNameBytes,
+	}
+	if err := out.Validate(); err != nil {
+		return OutputSize{}, err
+	}
+	return out, nil
+}
+
+// EstimateOutputBytesForAddress is EstimateOutputBytes with out.AddressBytes
+// replaced by the canonical size for addrType. Callers usually know an
+// address's type rather than its byte length, so this avoids a common
+// class of sizing mistakes.
+//
+// Example:
+//
+//	size, err := fees.EstimateOutputBytesForAddress(fees.OutputSize{
+//		NumPolicies:         1,
+//		NumAssets:           1,
+//		TotalAssetNameBytes: 9,
+//	}, fees.AddressEnterprise)
+func EstimateOutputBytesForAddress(out OutputSize, addrType AddressType) (uint64, error) {
+	addrBytes, err := AddressBytesForType(addrType)
+	if err != nil {
+		return 0, err
+	}
+	out.AddressBytes = addrBytes
+	return EstimateOutputBytes(out), nil
+}
+
+// MinUTxOForOutput is MinUTxO with out.AddressBytes replaced by the
+// canonical size for addrType.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOForOutput(p, fees.OutputSize{
+//		NumPolicies:         1,
+//		NumAssets:           1,
+//		TotalAssetNameBytes: 32,
+//	}, fees.AddressBase)
+func MinUTxOForOutput(p ProtocolParams, out OutputSize, addrType AddressType) (uint64, error) {
+	if err := validateMinUTxOParams(p); err != nil {
+		return 0, err
+	}
+	size, err := EstimateOutputBytesForAddress(out, addrType)
+	if err != nil {
+		return 0, err
+	}
+	return MinUTxOFromBytes(p, size)
+}
+
+// MinUTxOForAddressType returns the minimum Lovelace for an ADA-only output
+// to an address of type addrType. It is MinUTxOForOutput with an empty
+// OutputSize.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOForAddressType(p, fees.AddressEnterprise)
+func MinUTxOForAddressType(p ProtocolParams, addrType AddressType) (uint64, error) {
+	return MinUTxOForOutput(p, OutputSize{}, addrType)
+}
@@ -0,0 +1,187 @@
+package fees_test
+
+import (
+	"errors"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestAddressBytesForType(t *testing.T) {
+	tests := []struct {
+		addrType fees.AddressType
+		want     uint64
+		wantName string
+		wantErr  bool
+	}{
+		{fees.AddressBase, 57, "base", false},
+		{fees.AddressPointer, 35, "pointer", false},
+		{fees.AddressEnterprise, 29, "enterprise", false},
+		{fees.AddressReward, 29, "reward", false},
+		{fees.AddressByron, fees.ByronAddressBytes, "byron", false},
+		{fees.AddressType(99), 0, "AddressType(99)", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.wantName, func(t *testing.T) {
+			if got := tc.addrType.String(); got != tc.wantName {
+				t.Errorf("String() = %q, want %q", got, tc.wantName)
+			}
+			got, err := fees.AddressBytesForType(tc.addrType)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEstimateOutputBytesForAddress(t *testing.T) {
+	out := fees.OutputSize{AddressBytes: 1, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 9}
+
+	got, err := fees.EstimateOutputBytesForAddress(out, fees.AddressEnterprise)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out.AddressBytes = 29
+	if want := fees.EstimateOutputBytes(out); got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+
+	if _, err := fees.EstimateOutputBytesForAddress(out, fees.AddressType(99)); err == nil {
+		t.Error("expected error for unknown address type")
+	}
+}
+
+func TestMinUTxOForOutput(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	got, err := fees.MinUTxOForOutput(p, fees.OutputSize{}, fees.AddressBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := fees.MinUTxOADAOnly(p)
+	if got != want {
+		t.Errorf("base ADA-only = %d, want %d", got, want)
+	}
+
+	enterprise, err := fees.MinUTxOForOutput(p, fees.OutputSize{}, fees.AddressEnterprise)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enterprise >= got {
+		t.Errorf("enterprise %d should be below base %d", enterprise, got)
+	}
+
+	if _, err := fees.MinUTxOForOutput(p, fees.OutputSize{}, fees.AddressType(99)); err == nil {
+		t.Error("expected error for unknown address type")
+	}
+	if _, err := fees.MinUTxOForOutput(fees.ProtocolParams{}, fees.OutputSize{}, fees.AddressBase); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
+
+func TestAddressTypeFromHeaderByte(t *testing.T) {
+	tests := []struct {
+		header  byte
+		want    fees.AddressType
+		wantErr bool
+	}{
+		{0x01, fees.AddressBase, false},
+		{0x31, fees.AddressBase, false},
+		{0x41, fees.AddressPointer, false},
+		{0x51, fees.AddressPointer, false},
+		{0x61, fees.AddressEnterprise, false},
+		{0x71, fees.AddressEnterprise, false},
+		{0x82, fees.AddressByron, false},
+		{0xe1, fees.AddressReward, false},
+		{0xf0, fees.AddressReward, false},
+		{0x91, 0, true},
+		{0xd1, 0, true},
+	}
+
+	for _, tc := range tests {
+		got, err := fees.AddressTypeFromHeaderByte(tc.header)
+		if tc.wantErr {
+			if !errors.Is(err, fees.ErrUnknownAddressType) {
+				t.Errorf("AddressTypeFromHeaderByte(%#x) err = %v, want ErrUnknownAddressType", tc.header, err)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Errorf("AddressTypeFromHeaderByte(%#x) = %v, %v; want %v", tc.header, got, err, tc.want)
+		}
+	}
+}
+
+func TestAddressBytesFromHeader(t *testing.T) {
+	raw := make([]byte, 29)
+	raw[0] = 0x61
+	typ, n, err := fees.AddressBytesFromHeader(raw)
+	if err != nil || typ != fees.AddressEnterprise || n != 29 {
+		t.Errorf("AddressBytesFromHeader = %v, %d, %v; want enterprise, 29, nil", typ, n, err)
+	}
+	if want, _ := fees.AddressBytesForType(typ); n != want {
+		t.Errorf("length %d does not match canonical %d", n, want)
+	}
+
+	if _, _, err := fees.AddressBytesFromHeader(nil); !errors.Is(err, fees.ErrZeroBytes) {
+		t.Errorf("empty address err = %v, want ErrZeroBytes", err)
+	}
+	if _, _, err := fees.AddressBytesFromHeader([]byte{0x91, 0}); !errors.Is(err, fees.ErrUnknownAddressType) {
+		t.Errorf("reserved header err = %v, want ErrUnknownAddressType", err)
+	}
+}
+
+func TestNewOutputSizeForNFT(t *testing.T) {
+	out, err := fees.NewOutputSizeForNFT(fees.AddressEnterprise, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fees.OutputSize{AddressBytes: 29, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8}
+	if out != want {
+		t.Errorf("NewOutputSizeForNFT = %+v, want %+v", out, want)
+	}
+
+	if _, err := fees.NewOutputSizeForNFT(fees.AddressBase, 33); !errors.Is(err, fees.ErrAssetNameTooLong) {
+		t.Errorf("33-byte name err = %v, want ErrAssetNameTooLong", err)
+	}
+	if _, err := fees.NewOutputSizeForNFT(fees.AddressType(99), 8); !errors.Is(err, fees.ErrUnknownAddressType) {
+		t.Errorf("unknown type err = %v, want ErrUnknownAddressType", err)
+	}
+}
+
+func TestNewOutputSizeForFT(t *testing.T) {
+	out, err := fees.NewOutputSizeForFT(fees.AddressBase, 2, 3, 24)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fees.OutputSize{AddressBytes: 57, NumPolicies: 2, NumAssets: 3, TotalAssetNameBytes: 24}
+	if out != want {
+		t.Errorf("NewOutputSizeForFT = %+v, want %+v", out, want)
+	}
+
+	tests := []struct {
+		name                    string
+		policies, assets, names uint64
+	}{
+		{"no assets", 1, 0, 0},
+		{"more policies than assets", 3, 2, 10},
+		{"names too long", 1, 2, 65},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := fees.NewOutputSizeForFT(fees.AddressBase, tc.policies, tc.assets, tc.names); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
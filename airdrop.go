@@ -0,0 +1,105 @@
+package fees
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// airdropOverheadBytes is the size of an airdrop transaction without its
+// outputs: the base overhead plus one key-witnessed input.
+const airdropOverheadBytes = baseTxSize + bytesPerInput
+
+// EstimateFeeForAirdrop estimates an airdrop transaction: one key-witnessed
+// input paying numRecipients outputs that each look like
+// recipientOutputSize. It returns the fee and the total minUTxO the
+// recipient outputs must carry, so that the input must hold at least
+// fee + totalMinUTxO.
+//
+// Returns an error if numRecipients is zero, recipientOutputSize is
+// invalid, or the transaction would exceed p.MaxTxSize, in which case the
+// error wraps ErrTxTooLarge and the airdrop must be split.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, totalMinUTxO, err := fees.EstimateFeeForAirdrop(p, 100, fees.OutputSize{
+//		AddressBytes:        57,
+//		NumPolicies:         1,
+//		NumAssets:           1,
+//		TotalAssetNameBytes: 8,
+//	})
+func EstimateFeeForAirdrop(p ProtocolParams, numRecipients uint64, recipientOutputSize OutputSize) (fee uint64, totalMinUTxO uint64, err error) {
+	if err := p.Validate(); err != nil {
+		return 0, 0, err
+	}
+	if numRecipients == 0 {
+		return 0, 0, NewFeeError("numRecipients must be at least 1")
+	}
+	if err := recipientOutputSize.Validate(); err != nil {
+		return 0, 0, err
+	}
+
+	hi, outputsBytes := bits.Mul64(numRecipients, EstimateOutputBytes(recipientOutputSize))
+	if hi != 0 {
+		return 0, 0, NewFeeError("airdrop outputs size overflows uint64")
+	}
+	size, carry := bits.Add64(airdropOverheadBytes, outputsBytes, 0)
+	if carry != 0 {
+		return 0, 0, NewFeeError("transaction size overflows uint64")
+	}
+	fee, err = minFee(p, size)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	hi, totalMinUTxO = bits.Mul64(numRecipients, minUTxOCost(p, recipientOutputSize))
+	if hi != 0 {
+		return 0, 0, NewFeeError(fmt.Sprintf("total minUTxO for %d recipients overflows uint64", numRecipients))
+	}
+	return fee, totalMinUTxO, nil
+}
+
+// MaxOutputsPerTx returns how many outputs of outputSizeBytes each fit in a
+// transaction of at most p.MaxTxSize bytes alongside the fixed overhead of
+// one key-witnessed input, about 340 bytes:
+//
+//	(MaxTxSize - 340) / outputSizeBytes
+//
+// Returns an error if outputSizeBytes is zero, or if not even one output
+// fits, in which case the error wraps ErrTxTooLarge.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	n, err := fees.MaxOutputsPerTx(p, 76) // (16,384 - 340) / 76 = 211
+func MaxOutputsPerTx(p ProtocolParams, outputSizeBytes uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	if outputSizeBytes == 0 {
+		return 0, NewFeeError("outputSizeBytes must be greater than zero")
+	}
+	if p.MaxTxSize <= airdropOverheadBytes || outputSizeBytes > p.MaxTxSize-airdropOverheadBytes {
+		return 0, &TxFeeEstimationError{Cause: ErrTxTooLarge, Reason: fmt.Sprintf("a %d-byte output does not fit in MaxTxSize %d with %d bytes of overhead", outputSizeBytes, p.MaxTxSize, airdropOverheadBytes)}
+	}
+	return (p.MaxTxSize - airdropOverheadBytes) / outputSizeBytes, nil
+}
+
+// MaxAirdropRecipients returns how many ADA-only outputs to addresses of
+// type outputType fit in one airdrop transaction, the most recipients
+// EstimateFeeForAirdrop accepts for such outputs. Outputs carrying tokens
+// are larger; use MaxOutputsPerTx with their EstimateOutputBytes size.
+//
+// Returns an error if outputType is unknown.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	n, err := fees.MaxAirdropRecipients(p, fees.AddressBase) // 211
+func MaxAirdropRecipients(p ProtocolParams, outputType AddressType) (uint64, error) {
+	size, err := EstimateOutputBytesForAddress(OutputSize{}, outputType)
+	if err != nil {
+		return 0, err
+	}
+	return MaxOutputsPerTx(p, size)
+}
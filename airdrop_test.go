@@ -0,0 +1,125 @@
+package fees_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestEstimateFeeForAirdrop(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	nft := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8} // 134 bytes
+
+	tests := []struct {
+		name       string
+		recipients uint64
+		out        fees.OutputSize
+		wantFee    uint64
+		wantMinADA uint64
+		wantErr    error
+	}{
+		// 200 + 140 + 134 = 474 bytes; minUTxO (160 + 134) × 4,310.
+		{"one recipient", 1, nft, 44*474 + 155_381, 1_267_140, nil},
+		// 200 + 140 + 100 × 134 = 13,740 bytes.
+		{"100 recipients", 100, nft, 44*13_740 + 155_381, 126_714_000, nil},
+		// 200 + 140 + 150 × 134 = 20,440 bytes.
+		{"too many recipients", 150, nft, 0, 0, fees.ErrTxTooLarge},
+		{"size overflow", math.MaxUint64, nft, 0, 0, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, total, err := fees.EstimateFeeForAirdrop(p, tc.recipients, tc.out)
+			if tc.wantFee == 0 {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+					t.Errorf("got %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fee != tc.wantFee || total != tc.wantMinADA {
+				t.Errorf("got (%d, %d), want (%d, %d)", fee, total, tc.wantFee, tc.wantMinADA)
+			}
+		})
+	}
+
+	if _, _, err := fees.EstimateFeeForAirdrop(p, 0, nft); err == nil {
+		t.Error("expected error for zero recipients")
+	}
+	if _, _, err := fees.EstimateFeeForAirdrop(p, 10, fees.OutputSize{}); err == nil {
+		t.Error("expected error for invalid recipient output")
+	}
+}
+
+func TestMaxOutputsPerTx(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name    string
+		size    uint64
+		want    uint64
+		wantErr error
+	}{
+		{"ADA-only base address output", 76, 211, nil},
+		{"NFT output", 134, 119, nil},
+		{"largest single output", p.MaxTxSize - 340, 1, nil},
+		// A MaxTxSize output leaves no room for the input and base overhead,
+		// so EstimateFeeForAirdrop would reject it.
+		{"MaxTxSize output", p.MaxTxSize, 0, fees.ErrTxTooLarge},
+		{"larger than MaxTxSize", p.MaxTxSize + 1, 0, fees.ErrTxTooLarge},
+		{"zero size", 0, 0, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MaxOutputsPerTx(p, tc.size)
+			if tc.want == 0 {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				if tc.wantErr != nil && !errors.Is(err, tc.wantErr) {
+					t.Errorf("got %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMaxAirdropRecipients(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	n, err := fees.MaxAirdropRecipients(p, fees.AddressBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n < 30 {
+		t.Errorf("MaxAirdropRecipients(base) = %d, want at least 30", n)
+	}
+
+	// The limit agrees with EstimateFeeForAirdrop.
+	out := fees.OutputSize{AddressBytes: 57}
+	if _, _, err := fees.EstimateFeeForAirdrop(p, n, out); err != nil {
+		t.Errorf("EstimateFeeForAirdrop(%d recipients): %v", n, err)
+	}
+	if _, _, err := fees.EstimateFeeForAirdrop(p, n+1, out); !errors.Is(err, fees.ErrTxTooLarge) {
+		t.Errorf("EstimateFeeForAirdrop(%d recipients) = %v, want ErrTxTooLarge", n+1, err)
+	}
+
+	if _, err := fees.MaxAirdropRecipients(p, fees.AddressType(99)); err == nil {
+		t.Error("expected error for unknown address type")
+	}
+}
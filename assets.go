@@ -0,0 +1,137 @@
+package fees
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// MaxAssetNameBytes is the maximum length of a native asset name.
+const MaxAssetNameBytes = 32
+
+// IsValidAssetNameLength reports whether n bytes is a valid asset name
+// length: 0 to MaxAssetNameBytes inclusive.
+//
+// Example:
+//
+//	fees.IsValidAssetNameLength(uint64(len(name)))
+func IsValidAssetNameLength(n uint64) bool {
+	return n <= MaxAssetNameBytes
+}
+
+// policyIDBytes is the size of a policy ID. A policy ID is the Blake2b-224
+// hash of the minting policy script, so its size is fixed by the hash
+// function and is not a protocol parameter.
+const policyIDBytes = 28
+
+// blake2b224DigestBytes is the digest size of Blake2b-224.
+const blake2b224DigestBytes = 224 / 8
+
+// AssertPolicyIDIs28Bytes checks the package's policy ID size against the
+// Blake2b-224 digest size, 28 bytes, which the byte model and the
+// [28]byte policy ID type both assume. It returns nil unless the
+// constant has been changed by mistake.
+//
+// Example:
+//
+//	if err := fees.AssertPolicyIDIs28Bytes(); err != nil {
+//		panic(err)
+//	}
+func AssertPolicyIDIs28Bytes() error {
+	if policyIDBytes != blake2b224DigestBytes {
+		return fmt.Errorf("fees: policy ID size is %d bytes, but a Blake2b-224 policy hash is always %d bytes", policyIDBytes, blake2b224DigestBytes)
+	}
+	return nil
+}
+
+// ErrInvalidPolicyID is wrapped by the errors returned from PolicyIDFromHex
+// and ValidatePolicyIDHex, for matching with errors.Is.
+var ErrInvalidPolicyID = errors.New("fees: invalid policy ID")
+
+// PolicyIDFromHex decodes a policy ID from its 56-character hex form, as
+// returned by chain APIs. Upper- and lowercase hex are both accepted.
+//
+// Example:
+//
+//	id, err := fees.PolicyIDFromHex("b0d07d45fe9514f80213f4020e5a61241458be626841cde717cb38a7")
+func PolicyIDFromHex(s string) ([28]byte, error) {
+	var id [28]byte
+	if len(s) != 2*len(id) {
+		return [28]byte{}, fmt.Errorf("%w: want 56 hex characters, got %d", ErrInvalidPolicyID, len(s))
+	}
+	if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+		return [28]byte{}, fmt.Errorf("%w: %v", ErrInvalidPolicyID, err)
+	}
+	return id, nil
+}
+
+// PolicyIDToHex encodes id as 56 lowercase hex characters.
+//
+// Example:
+//
+//	s := fees.PolicyIDToHex(id)
+func PolicyIDToHex(id [28]byte) string {
+	return hex.EncodeToString(id[:])
+}
+
+// ValidatePolicyIDHex checks that s is a 56-character hex string encoding
+// a 28-byte policy ID. The returned error wraps ErrInvalidPolicyID.
+//
+// Example:
+//
+//	if err := fees.ValidatePolicyIDHex(s); err != nil {
+//		return err
+//	}
+func ValidatePolicyIDHex(s string) error {
+	_, err := PolicyIDFromHex(s)
+	return err
+}
+
+// TokenBundle maps each 28-byte policy ID to the asset names held under it.
+// It describes the native-token part of an output's value.
+type TokenBundle map[[28]byte][][]byte
+
+// outputSize derives the token fields of an OutputSize from b, validating
+// that every policy has at least one asset and every name is at most 32
+// bytes.
+func (b TokenBundle) outputSize() (OutputSize, error) {
+	var out OutputSize
+	for policy, names := range b {
+		if len(names) == 0 {
+			return OutputSize{}, newMinUTxOError(ErrCodeEmptyBundle, ErrEmptyBundle, fmt.Sprintf("policy %x has no assets", policy))
+		}
+		out.NumPolicies++
+		for _, name := range names {
+			if !IsValidAssetNameLength(uint64(len(name))) {
+				return OutputSize{}, newMinUTxOError(ErrCodeAssetNameTooLong, ErrAssetNameTooLong, fmt.Sprintf("asset name %x exceeds maximum of 32 bytes", name))
+			}
+			out.NumAssets++
+			out.TotalAssetNameBytes += uint64(len(name))
+		}
+	}
+	return out, nil
+}
+
+// MinUTxOForTokenBundle returns the minimum Lovelace for an output to an
+// address of type addrType holding bundle. NumPolicies, NumAssets, and
+// TotalAssetNameBytes are derived from the bundle, so callers work with
+// actual policy IDs and asset names rather than counts. An empty bundle
+// gives the ADA-only minimum.
+//
+// Returns an error if any policy has no assets or any asset name exceeds
+// 32 bytes.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	var policy [28]byte // decoded policy ID
+//	minADA, err := fees.MinUTxOForTokenBundle(p, fees.AddressBase, fees.TokenBundle{
+//		policy: {[]byte("MyNFT001"), []byte("MyNFT002")},
+//	})
+func MinUTxOForTokenBundle(p ProtocolParams, addrType AddressType, bundle TokenBundle) (uint64, error) {
+	out, err := bundle.outputSize()
+	if err != nil {
+		return 0, err
+	}
+	return MinUTxOForOutput(p, out, addrType)
+}
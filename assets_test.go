@@ -0,0 +1,129 @@
+package fees_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestMinUTxOForTokenBundle(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	var policyA, policyB [28]byte
+	policyB[0] = 1
+
+	tests := []struct {
+		name    string
+		bundle  fees.TokenBundle
+		want    fees.OutputSize
+		wantErr bool
+	}{
+		{
+			name:   "empty bundle",
+			bundle: fees.TokenBundle{},
+			want:   fees.OutputSize{AddressBytes: 57},
+		},
+		{
+			name:   "two policies three assets",
+			bundle: fees.TokenBundle{policyA: {[]byte("MyNFT001"), []byte("MyNFT002")}, policyB: {[]byte("TOKEN")}},
+			want:   fees.OutputSize{AddressBytes: 57, NumPolicies: 2, NumAssets: 3, TotalAssetNameBytes: 21},
+		},
+		{
+			name:   "empty asset name",
+			bundle: fees.TokenBundle{policyA: {{}}},
+			want:   fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1},
+		},
+		{
+			name:    "policy without assets",
+			bundle:  fees.TokenBundle{policyA: nil},
+			wantErr: true,
+		},
+		{
+			name:    "asset name too long",
+			bundle:  fees.TokenBundle{policyA: {bytes.Repeat([]byte{'x'}, 33)}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MinUTxOForTokenBundle(p, fees.AddressBase, tc.bundle)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want, _ := fees.MinUTxO(p, tc.want)
+			if got != want {
+				t.Errorf("got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestPolicyIDHex(t *testing.T) {
+	const valid = "b0d07d45fe9514f80213f4020e5a61241458be626841cde717cb38a7"
+
+	tests := []struct {
+		name    string
+		s       string
+		wantErr bool
+	}{
+		{"valid", valid, false},
+		{"uppercase", strings.ToUpper(valid), false},
+		{"empty", "", true},
+		{"too short", valid[:54], true},
+		{"too long", valid + "00", true},
+		{"not hex", "zz" + valid[2:], true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := fees.PolicyIDFromHex(tc.s)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("PolicyIDFromHex err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if verr := fees.ValidatePolicyIDHex(tc.s); (verr != nil) != tc.wantErr {
+				t.Errorf("ValidatePolicyIDHex err = %v, wantErr %v", verr, tc.wantErr)
+			}
+			if tc.wantErr {
+				if !errors.Is(err, fees.ErrInvalidPolicyID) {
+					t.Errorf("err = %v, want ErrInvalidPolicyID", err)
+				}
+				return
+			}
+			if got := fees.PolicyIDToHex(id); got != valid {
+				t.Errorf("PolicyIDToHex = %q, want %q", got, valid)
+			}
+		})
+	}
+}
+
+func TestIsValidAssetNameLength(t *testing.T) {
+	for n, want := range map[uint64]bool{0: true, 9: true, 32: true, 33: false} {
+		if got := fees.IsValidAssetNameLength(n); got != want {
+			t.Errorf("IsValidAssetNameLength(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
+
+// TestPolicyIDIsAlways28Bytes is a canary: the byte model and the [28]byte
+// policy ID type both assume the Blake2b-224 digest size.
+func TestPolicyIDIsAlways28Bytes(t *testing.T) {
+	if err := fees.AssertPolicyIDIs28Bytes(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each extra policy adds its 28-byte hash and a 17-byte asset entry.
+	one := fees.TokenBundleValueBytes(fees.OutputSize{NumPolicies: 1, NumAssets: 1})
+	two := fees.TokenBundleValueBytes(fees.OutputSize{NumPolicies: 2, NumAssets: 2})
+	if got := two - one - 17; got != 28 {
+		t.Errorf("policy ID contributes %d bytes to the value estimate, want 28", got)
+	}
+}
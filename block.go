@@ -0,0 +1,115 @@
+package fees
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BlockFeeRevenue returns the total fees collected from a block's
+// transactions, for modeling block producer revenue.
+//
+// Returns an error if the sum overflows uint64.
+//
+// Example:
+//
+//	total, err := fees.BlockFeeRevenue([]uint64{170_000, 185_000, 240_000}) // 595,000
+func BlockFeeRevenue(txFees []uint64) (total uint64, err error) {
+	return SumLovelace(txFees)
+}
+
+// BlockFeeRevenuePerByte returns the block's fee revenue per byte of block
+// body, rounded down, for comparing how efficiently blocks use space.
+//
+// Returns a *FeeError if totalBlockBytes is zero.
+//
+// Example:
+//
+//	perByte, err := fees.BlockFeeRevenuePerByte(txFees, 60_000)
+func BlockFeeRevenuePerByte(txFees []uint64, totalBlockBytes uint64) (uint64, error) {
+	if totalBlockBytes == 0 {
+		return 0, NewFeeError("totalBlockBytes must be greater than zero")
+	}
+	total, err := BlockFeeRevenue(txFees)
+	if err != nil {
+		return 0, err
+	}
+	return total / totalBlockBytes, nil
+}
+
+// EstimateBlockRevenue returns the expected fee revenue of a block holding
+// numTxs transactions of avgTxSizeBytes each, each paying exactly the
+// minimum fee: numTxs * MinFee(p, avgTxSizeBytes).
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	revenue, err := fees.EstimateBlockRevenue(p, 150, 600)
+func EstimateBlockRevenue(p ProtocolParams, numTxs, avgTxSizeBytes uint64) (uint64, error) {
+	fee, err := MinFee(p, avgTxSizeBytes)
+	if err != nil {
+		return 0, err
+	}
+	hi, revenue := bits.Mul64(numTxs, fee)
+	if hi != 0 {
+		return 0, NewFeeError(fmt.Sprintf("revenue of %d transactions overflows uint64", numTxs))
+	}
+	return revenue, nil
+}
+
+// EstimateTransactionsPerBlock returns how many transactions of
+// avgTxSizeBytes fit in a block body: p.MaxBlockBodySize / avgTxSizeBytes,
+// rounded down.
+//
+// Returns a *ParamError if p is invalid or p.MaxBlockBodySize is zero, or
+// a *TxFeeEstimationError if avgTxSizeBytes is zero or exceeds MaxTxSize.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	n, err := fees.EstimateTransactionsPerBlock(p, 600) // 90112 / 600 = 150
+func EstimateTransactionsPerBlock(p ProtocolParams, avgTxSizeBytes uint64) (uint64, error) {
+	if err := validateBlockParams(p); err != nil {
+		return 0, err
+	}
+	if err := checkTxSize(p, avgTxSizeBytes); err != nil {
+		return 0, err
+	}
+	return p.MaxBlockBodySize / avgTxSizeBytes, nil
+}
+
+// BlockCapacityUsed returns how many bytes of the block body the
+// transactions of txSizes use, and how many of p.MaxBlockBodySize remain.
+//
+// Returns a *ParamError if p is invalid or p.MaxBlockBodySize is zero, and
+// a *FeeError if the transactions do not fit in one block.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	used, remaining, err := fees.BlockCapacityUsed(p, []uint64{600, 1_200, 16_384})
+//	// used = 18,184, remaining = 71,928
+func BlockCapacityUsed(p ProtocolParams, txSizes []uint64) (usedBytes, remainingBytes uint64, err error) {
+	if err := validateBlockParams(p); err != nil {
+		return 0, 0, err
+	}
+	var used uint64
+	for _, size := range txSizes {
+		var carry uint64
+		used, carry = bits.Add64(used, size, 0)
+		if carry != 0 || used > p.MaxBlockBodySize {
+			return 0, 0, NewFeeError(fmt.Sprintf("%d transactions exceed MaxBlockBodySize %d", len(txSizes), p.MaxBlockBodySize))
+		}
+	}
+	return used, p.MaxBlockBodySize - used, nil
+}
+
+// validateBlockParams validates p and checks that MaxBlockBodySize is set.
+func validateBlockParams(p ProtocolParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	if p.MaxBlockBodySize == 0 {
+		return &ParamError{Field: "MaxBlockBodySize", Message: "must be non-zero for block capacity calculations"}
+	}
+	return nil
+}
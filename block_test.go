@@ -0,0 +1,112 @@
+package fees_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestBlockFeeRevenue(t *testing.T) {
+	txFees := []uint64{170_000, 185_000, 240_000}
+
+	total, err := fees.BlockFeeRevenue(txFees)
+	if err != nil || total != 595_000 {
+		t.Errorf("BlockFeeRevenue = %d, %v; want 595000", total, err)
+	}
+	if total, err := fees.BlockFeeRevenue(nil); err != nil || total != 0 {
+		t.Errorf("BlockFeeRevenue(nil) = %d, %v; want 0", total, err)
+	}
+	if _, err := fees.BlockFeeRevenue([]uint64{math.MaxUint64, 1}); err == nil {
+		t.Error("expected overflow error")
+	}
+
+	perByte, err := fees.BlockFeeRevenuePerByte(txFees, 1_000)
+	if err != nil || perByte != 595 {
+		t.Errorf("BlockFeeRevenuePerByte = %d, %v; want 595", perByte, err)
+	}
+	if _, err := fees.BlockFeeRevenuePerByte(txFees, 0); err == nil {
+		t.Error("expected error for zero block bytes")
+	}
+}
+
+func TestEstimateBlockRevenue(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	got, err := fees.EstimateBlockRevenue(p, 150, 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := uint64(150 * (44*600 + 155381)); got != want {
+		t.Errorf("EstimateBlockRevenue = %d, want %d", got, want)
+	}
+	if got, _ := fees.EstimateBlockRevenue(p, 0, 600); got != 0 {
+		t.Errorf("empty block revenue = %d, want 0", got)
+	}
+	if _, err := fees.EstimateBlockRevenue(p, math.MaxUint64, 600); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func TestEstimateTransactionsPerBlock(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name    string
+		avgSize uint64
+		want    uint64
+		wantErr error
+	}{
+		{"typical", 600, 150, nil},
+		{"max size", 16_384, 5, nil},
+		{"zero", 0, 0, fees.ErrTxTooSmall},
+		{"above MaxTxSize", 16_385, 0, fees.ErrTxTooLarge},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.EstimateTransactionsPerBlock(p, tc.avgSize)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("err = %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tc.want {
+				t.Errorf("EstimateTransactionsPerBlock(%d) = %d, %v; want %d", tc.avgSize, got, err, tc.want)
+			}
+		})
+	}
+
+	p.MaxBlockBodySize = 0
+	var pe *fees.ParamError
+	if _, err := fees.EstimateTransactionsPerBlock(p, 600); !errors.As(err, &pe) {
+		t.Errorf("err = %v, want *ParamError", err)
+	}
+}
+
+func TestBlockCapacityUsed(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	used, remaining, err := fees.BlockCapacityUsed(p, []uint64{600, 1_200, 16_384})
+	if err != nil || used != 18_184 || remaining != 90_112-18_184 {
+		t.Errorf("BlockCapacityUsed = %d, %d, %v; want 18184, 71928, nil", used, remaining, err)
+	}
+
+	used, remaining, err = fees.BlockCapacityUsed(p, nil)
+	if err != nil || used != 0 || remaining != 90_112 {
+		t.Errorf("empty block = %d, %d, %v; want 0, 90112, nil", used, remaining, err)
+	}
+
+	full := make([]uint64, 6)
+	for i := range full {
+		full[i] = 16_384
+	}
+	if _, _, err := fees.BlockCapacityUsed(p, full); err == nil {
+		t.Error("expected error for transactions exceeding MaxBlockBodySize")
+	}
+	if _, _, err := fees.BlockCapacityUsed(p, []uint64{math.MaxUint64, 2}); err == nil {
+		t.Error("expected error for overflowing sizes")
+	}
+}
@@ -0,0 +1,65 @@
+package fees
+
+// MinUTxOCalculator computes minUTxO values under a fixed set of protocol
+// parameters that were validated once, when it was created. Its methods
+// cannot fail, which suits hot paths that size thousands of outputs
+// against the same params.
+//
+// Example:
+//
+//	c, err := fees.NewMinUTxOCalculator(fees.DefaultMainnetParams())
+//	if err != nil {
+//		return err
+//	}
+//	for _, out := range outputs {
+//		total += c.Calculate(out)
+//	}
+type MinUTxOCalculator struct {
+	p ProtocolParams
+}
+
+// NewMinUTxOCalculator validates p and returns a calculator that uses it.
+// The calculator keeps its own copy of p.
+//
+// Example:
+//
+//	c, err := fees.NewMinUTxOCalculator(fees.DefaultMainnetParams())
+func NewMinUTxOCalculator(p ProtocolParams) (*MinUTxOCalculator, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return &MinUTxOCalculator{p: p}, nil
+}
+
+// Calculate returns MinUTxO for out under the calculator's params.
+//
+// Example:
+//
+//	minADA := c.Calculate(fees.OutputSize{AddressBytes: 57}) // 1,017,160
+func (c *MinUTxOCalculator) Calculate(out OutputSize) uint64 {
+	return minUTxOCost(c.p, out)
+}
+
+// IsAbove reports whether lovelace meets or exceeds the minUTxO for out,
+// as IsAboveMinUTxO does.
+//
+// Example:
+//
+//	ok := c.IsAbove(2_000_000, fees.OutputSize{AddressBytes: 57}) // true
+func (c *MinUTxOCalculator) IsAbove(lovelace uint64, out OutputSize) bool {
+	return lovelace >= c.Calculate(out)
+}
+
+// Deficit returns how many Lovelace must be added to lovelace for it to
+// meet the minUTxO for out, or 0 if it already does.
+//
+// Example:
+//
+//	short := c.Deficit(1_000_000, fees.OutputSize{AddressBytes: 57}) // 17,160
+func (c *MinUTxOCalculator) Deficit(lovelace uint64, out OutputSize) uint64 {
+	required := c.Calculate(out)
+	if lovelace >= required {
+		return 0
+	}
+	return required - lovelace
+}
@@ -0,0 +1,54 @@
+package fees_test
+
+import (
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestMinUTxOCalculator(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	c, err := fees.NewMinUTxOCalculator(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outputs := []fees.OutputSize{
+		{AddressBytes: 57},
+		{AddressBytes: 29},
+		{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 32},
+		{AddressBytes: 57, NumPolicies: 3, NumAssets: 10, TotalAssetNameBytes: 200, HasDatumHash: true},
+	}
+	for _, out := range outputs {
+		want, err := fees.MinUTxO(p, out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := c.Calculate(out); got != want {
+			t.Errorf("Calculate(%+v) = %d, want %d", out, got, want)
+		}
+
+		tests := []struct {
+			lovelace    uint64
+			wantAbove   bool
+			wantDeficit uint64
+		}{
+			{0, false, want},
+			{want - 1, false, 1},
+			{want, true, 0},
+			{want + 1, true, 0},
+		}
+		for _, tc := range tests {
+			if got := c.IsAbove(tc.lovelace, out); got != tc.wantAbove {
+				t.Errorf("IsAbove(%d, %+v) = %v, want %v", tc.lovelace, out, got, tc.wantAbove)
+			}
+			if got := c.Deficit(tc.lovelace, out); got != tc.wantDeficit {
+				t.Errorf("Deficit(%d, %+v) = %d, want %d", tc.lovelace, out, got, tc.wantDeficit)
+			}
+		}
+	}
+
+	if _, err := fees.NewMinUTxOCalculator(fees.ProtocolParams{}); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
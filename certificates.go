@@ -0,0 +1,260 @@
+package fees
+
+import "fmt"
+
+// CertificateType identifies the kind of a certificate carried in a
+// transaction body. Some certificates lock a refundable deposit; see
+// CertificateDeposit.
+type CertificateType uint8
+
+const (
+	// CertStakeRegistration registers a stake key. Requires KeyDeposit.
+	CertStakeRegistration CertificateType = iota
+
+	// CertStakeDeregistration deregisters a stake key, refunding its
+	// deposit.
+	CertStakeDeregistration
+
+	// CertStakeDelegation delegates a registered stake key to a pool.
+	CertStakeDelegation
+
+	// CertPoolRegistration registers or updates a stake pool. Requires
+	// PoolDeposit.
+	CertPoolRegistration
+
+	// CertPoolRetirement schedules a stake pool's retirement.
+	CertPoolRetirement
+
+	// CertDRepRegistration registers a delegated representative (Conway).
+	// Requires DRepDeposit.
+	CertDRepRegistration
+
+	// CertDRepDeregistration deregisters a delegated representative,
+	// refunding its deposit.
+	CertDRepDeregistration
+
+	// CertVoteDelegation delegates voting power to a DRep (Conway).
+	CertVoteDelegation
+)
+
+// String returns the certificate type's name, e.g. "stake_registration".
+func (c CertificateType) String() string {
+	switch c {
+	case CertStakeRegistration:
+		return "stake_registration"
+	case CertStakeDeregistration:
+		return "stake_deregistration"
+	case CertStakeDelegation:
+		return "stake_delegation"
+	case CertPoolRegistration:
+		return "pool_registration"
+	case CertPoolRetirement:
+		return "pool_retirement"
+	case CertDRepRegistration:
+		return "drep_registration"
+	case CertDRepDeregistration:
+		return "drep_deregistration"
+	case CertVoteDelegation:
+		return "vote_delegation"
+	default:
+		return fmt.Sprintf("CertificateType(%d)", uint8(c))
+	}
+}
+
+// CertificateDeposit returns the deposit, in Lovelace, that submitting a
+// certificate of type cert locks:
+//
+//	CertStakeRegistration → p.KeyDeposit
+//	CertPoolRegistration  → p.PoolDeposit
+//	CertDRepRegistration  → p.DRepDeposit
+//
+// and 0 for every other certificate. Deregistration refunds are not
+// deposits and also report 0. Returns a *ParamError if the relevant
+// deposit field is zero, and a *FeeError for an unknown certificate type.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	deposit, err := fees.CertificateDeposit(p, fees.CertStakeRegistration) // 2,000,000
+func CertificateDeposit(p ProtocolParams, cert CertificateType) (uint64, error) {
+	var field string
+	var deposit uint64
+	switch cert {
+	case CertStakeRegistration:
+		field, deposit = "KeyDeposit", p.KeyDeposit
+	case CertPoolRegistration:
+		field, deposit = "PoolDeposit", p.PoolDeposit
+	case CertDRepRegistration:
+		field, deposit = "DRepDeposit", p.DRepDeposit
+	case CertStakeDeregistration, CertStakeDelegation, CertPoolRetirement,
+		CertDRepDeregistration, CertVoteDelegation:
+		return 0, nil
+	default:
+		return 0, NewFeeError(fmt.Sprintf("unknown certificate type %d", uint8(cert)))
+	}
+	if deposit == 0 {
+		return 0, &ParamError{Field: field, Message: "must be non-zero for " + cert.String() + " certificates"}
+	}
+	return deposit, nil
+}
+
+// EstimateStakeDelegationTxFee estimates the fee for a stake delegation
+// transaction, optionally registering the stake key in the same
+// transaction, and returns the fee, the key deposit locked (p.KeyDeposit
+// when withRegistration, else 0), and their sum.
+//
+// The transaction is assumed to have 1 input, 1 change output, and 2 VKey
+// witnesses (payment key and stake key), sized as:
+//
+//	base tx overhead:         200 bytes
+//	input + payment witness:  140 bytes
+//	change output:             65 bytes
+//	stake key witness:        100 bytes
+//	certificates field:         3 bytes
+//	delegation certificate:    64 bytes
+//	registration certificate:  35 bytes (withRegistration only)
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, deposit, total, err := fees.EstimateStakeDelegationTxFee(p, true)
+//	// size = 607 bytes; deposit = 2 ADA
+func EstimateStakeDelegationTxFee(p ProtocolParams, withRegistration bool) (fee uint64, keyDeposit uint64, total uint64, err error) {
+	const (
+		delegationCertBytes    uint64 = 64
+		stakeRegistrationBytes uint64 = 35
+	)
+	if err := p.Validate(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	size := structuralTxSize(1, 1, false) + vkeyWitnessBytes + certsFieldBytes + delegationCertBytes
+	if withRegistration {
+		size += stakeRegistrationBytes
+		if keyDeposit, err = CertificateDeposit(p, CertStakeRegistration); err != nil {
+			return 0, 0, 0, err
+		}
+	}
+
+	if fee, err = minFee(p, size); err != nil {
+		return 0, 0, 0, err
+	}
+	if total, err = AddLovelace(fee, keyDeposit); err != nil {
+		return 0, 0, 0, err
+	}
+	return fee, keyDeposit, total, nil
+}
+
+// certsFieldBytes is the body key and array header of the certificates
+// field.
+const certsFieldBytes uint64 = 3
+
+// CertificateByteEstimate returns the approximate CBOR size, in bytes, of
+// one certificate of type cert:
+//
+//	stake_registration, stake_deregistration:  35
+//	stake_delegation:                          64
+//	pool_registration:                        400 (typical relays and metadata)
+//	pool_retirement:                           40
+//	drep_registration:                        110 (with anchor)
+//	drep_deregistration:                       45
+//	vote_delegation:                           65
+//
+// Returns a *FeeError for an unknown certificate type.
+//
+// Example:
+//
+//	n, err := fees.CertificateByteEstimate(fees.CertStakeDelegation) // 64
+func CertificateByteEstimate(cert CertificateType) (uint64, error) {
+	switch cert {
+	case CertStakeRegistration, CertStakeDeregistration:
+		return 35, nil
+	case CertStakeDelegation:
+		return 64, nil
+	case CertPoolRegistration:
+		return 400, nil
+	case CertPoolRetirement:
+		return 40, nil
+	case CertDRepRegistration:
+		return 110, nil
+	case CertDRepDeregistration:
+		return 45, nil
+	case CertVoteDelegation:
+		return 65, nil
+	default:
+		return 0, NewFeeError(fmt.Sprintf("unknown certificate type %d", uint8(cert)))
+	}
+}
+
+// EstimateFeeWithCertificates estimates the fee for a transaction with
+// numInputs key-witnessed inputs, numOutputs outputs, and the given
+// certificates. extraWitnesses is the number of VKey witnesses the
+// certificates need beyond the payment witnesses, such as a stake key or
+// pool cold key; certificates signed by the same key share one witness,
+// so it is not derived from certs. Deposits are not included; see
+// CertificateDeposit.
+//
+//	size = 200 + 140*numInputs + 65*numOutputs
+//	     + 3 + Σ CertificateByteEstimate (if any certificates)
+//	     + 100*extraWitnesses
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.EstimateFeeWithCertificates(p, 1, 1,
+//		[]fees.CertificateType{fees.CertStakeDelegation}, 1)
+func EstimateFeeWithCertificates(p ProtocolParams, numInputs, numOutputs uint64, certs []CertificateType, extraWitnesses uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	if numInputs == 0 {
+		return 0, NewFeeError("numInputs must be at least 1")
+	}
+	if numOutputs == 0 {
+		return 0, NewFeeError("numOutputs must be at least 1")
+	}
+
+	size := structuralTxSize(numInputs, numOutputs, false) + vkeyWitnessBytes*extraWitnesses
+	if len(certs) > 0 {
+		size += certsFieldBytes
+	}
+	for _, cert := range certs {
+		n, err := CertificateByteEstimate(cert)
+		if err != nil {
+			return 0, err
+		}
+		size += n
+	}
+	return minFee(p, size)
+}
+
+// PoolRetirementByteEstimate returns the approximate CBOR size, in bytes,
+// of a pool retirement certificate: the certificate tag, the 28-byte pool
+// key hash, and the retirement epoch. 40 bytes.
+//
+// Example:
+//
+//	fees.PoolRetirementByteEstimate() // 40
+func PoolRetirementByteEstimate() uint64 {
+	n, _ := CertificateByteEstimate(CertPoolRetirement)
+	return n
+}
+
+// PoolRetirementFee estimates the fee for a pool retirement transaction.
+// The transaction is assumed to have numInputs inputs, one change output,
+// one pool retirement certificate, and, besides a payment witness per
+// input, one witness from the pool's cold key:
+//
+//	size = 200 + 140*numInputs + 65 + 3 + 40 + 100
+//
+// Retirement charges no deposit: the pool deposit is refunded to the
+// pool's reward account at the retirement epoch, not in this transaction.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.PoolRetirementFee(p, 1)
+//	// size = 548 bytes
+func PoolRetirementFee(p ProtocolParams, numInputs uint64) (uint64, error) {
+	return EstimateFeeWithCertificates(p, numInputs, 1, []CertificateType{CertPoolRetirement}, 1)
+}
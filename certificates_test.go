@@ -0,0 +1,153 @@
+package fees_test
+
+import (
+	"errors"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestCertificateDeposit(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		cert fees.CertificateType
+		want uint64
+	}{
+		{fees.CertStakeRegistration, 2_000_000},
+		{fees.CertStakeDeregistration, 0},
+		{fees.CertStakeDelegation, 0},
+		{fees.CertPoolRegistration, 500_000_000},
+		{fees.CertPoolRetirement, 0},
+		{fees.CertDRepRegistration, 500_000_000},
+		{fees.CertDRepDeregistration, 0},
+		{fees.CertVoteDelegation, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.cert.String(), func(t *testing.T) {
+			got, err := fees.CertificateDeposit(p, tc.cert)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("CertificateDeposit(%v) = %d, want %d", tc.cert, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCertificateDepositErrors(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	p.PoolDeposit = 0
+
+	_, err := fees.CertificateDeposit(p, fees.CertPoolRegistration)
+	var pe *fees.ParamError
+	if !errors.As(err, &pe) || pe.Field != "PoolDeposit" {
+		t.Errorf("err = %v, want *ParamError for PoolDeposit", err)
+	}
+
+	if got, err := fees.CertificateDeposit(p, fees.CertStakeDelegation); err != nil || got != 0 {
+		t.Errorf("delegation = %d, %v; want 0, nil", got, err)
+	}
+
+	var fe *fees.FeeError
+	if _, err := fees.CertificateDeposit(p, fees.CertificateType(99)); !errors.As(err, &fe) {
+		t.Errorf("err = %v, want *FeeError for unknown type", err)
+	}
+	if got := fees.CertificateType(99).String(); got != "CertificateType(99)" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestEstimateStakeDelegationTxFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name             string
+		withRegistration bool
+		wantFee          uint64
+		wantDeposit      uint64
+	}{
+		{"delegation only", false, 44*572 + 155381, 0},
+		{"with registration", true, 44*607 + 155381, 2_000_000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, deposit, total, err := fees.EstimateStakeDelegationTxFee(p, tc.withRegistration)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fee != tc.wantFee || deposit != tc.wantDeposit || total != tc.wantFee+tc.wantDeposit {
+				t.Errorf("got (%d, %d, %d), want (%d, %d, %d)",
+					fee, deposit, total, tc.wantFee, tc.wantDeposit, tc.wantFee+tc.wantDeposit)
+			}
+		})
+	}
+
+	p.KeyDeposit = 0
+	if _, _, _, err := fees.EstimateStakeDelegationTxFee(p, true); err == nil {
+		t.Error("expected error for zero KeyDeposit with registration")
+	}
+	if _, _, _, err := fees.EstimateStakeDelegationTxFee(p, false); err != nil {
+		t.Errorf("delegation without registration should not need KeyDeposit: %v", err)
+	}
+}
+
+func TestEstimateFeeWithCertificates(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name     string
+		certs    []fees.CertificateType
+		extra    uint64
+		wantSize uint64
+	}{
+		{"no certificates", nil, 0, 405},
+		{"delegation", []fees.CertificateType{fees.CertStakeDelegation}, 1, 405 + 3 + 64 + 100},
+		{"registration and delegation", []fees.CertificateType{fees.CertStakeRegistration, fees.CertStakeDelegation}, 1, 405 + 3 + 35 + 64 + 100},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.EstimateFeeWithCertificates(p, 1, 1, tc.certs, tc.extra)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want, _ := fees.MinFee(p, tc.wantSize); got != want {
+				t.Errorf("fee = %d, want MinFee(%d) = %d", got, tc.wantSize, want)
+			}
+		})
+	}
+
+	// Registration plus delegation matches the dedicated estimator.
+	fee, _, _, _ := fees.EstimateStakeDelegationTxFee(p, true)
+	got, _ := fees.EstimateFeeWithCertificates(p, 1, 1, []fees.CertificateType{fees.CertStakeRegistration, fees.CertStakeDelegation}, 1)
+	if got != fee {
+		t.Errorf("EstimateFeeWithCertificates = %d, want EstimateStakeDelegationTxFee %d", got, fee)
+	}
+
+	var fe *fees.FeeError
+	if _, err := fees.EstimateFeeWithCertificates(p, 1, 1, []fees.CertificateType{fees.CertificateType(99)}, 0); !errors.As(err, &fe) {
+		t.Errorf("unknown certificate err = %v, want *FeeError", err)
+	}
+}
+
+func TestPoolRetirementFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	if got := fees.PoolRetirementByteEstimate(); got != 40 {
+		t.Errorf("PoolRetirementByteEstimate() = %d, want 40", got)
+	}
+	got, err := fees.PoolRetirementFee(p, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinFee(p, 548); got != want {
+		t.Errorf("PoolRetirementFee = %d, want MinFee(548) = %d", got, want)
+	}
+	if _, err := fees.PoolRetirementFee(p, 0); err == nil {
+		t.Error("expected error for zero inputs")
+	}
+}
@@ -0,0 +1,169 @@
+package fees
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CalculateChangeSplit splits totalChange as evenly as possible across
+// numOutputs change outputs, as wallets that spread change over several
+// addresses for privacy do. Each output gets totalChange / numOutputs,
+// rounded down, and the remainder goes to the last output.
+//
+// Returns a *FeeError if numOutputs is not positive.
+//
+// Example:
+//
+//	split, err := fees.CalculateChangeSplit(10_000_001, 3)
+//	// [3333333 3333333 3333335]
+func CalculateChangeSplit(totalChange uint64, numOutputs int) ([]uint64, error) {
+	if numOutputs <= 0 {
+		return nil, NewFeeError(fmt.Sprintf("numOutputs must be positive, got %d", numOutputs))
+	}
+	n := uint64(numOutputs)
+	split := make([]uint64, numOutputs)
+	for i := range split {
+		split[i] = totalChange / n
+	}
+	split[numOutputs-1] += totalChange % n
+	return split, nil
+}
+
+// CalculateChangeSplitWithMinUTxO splits totalChange across change outputs
+// so that each output meets its own minUTxO. Each output first receives
+// its minUTxO; the rest is then shared out as in CalculateChangeSplit.
+// The returned slice is parallel to outputs.
+//
+// Returns a *FeeError if outputs is empty, and an *InsufficientFundsError
+// if totalChange cannot cover every output's minUTxO. Its Shortfall is the
+// Lovelace missing, and its Cause a *MinUTxOError with
+// ErrCodeBelowMinUTxO, so errors.Is matches both that code and
+// ErrBelowMinUTxO.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	split, err := fees.CalculateChangeSplitWithMinUTxO(p, 5_000_000, []fees.OutputSize{
+//		{AddressBytes: 57},
+//		{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 9},
+//	})
+func CalculateChangeSplitWithMinUTxO(p ProtocolParams, totalChange uint64, outputs []OutputSize) ([]uint64, error) {
+	if len(outputs) == 0 {
+		return nil, NewFeeError("at least one change output is required")
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	mins := make([]uint64, len(outputs))
+	for i, out := range outputs {
+		minADA, err := MinUTxO(p, out)
+		if err != nil {
+			return nil, err
+		}
+		mins[i] = minADA
+	}
+	required, err := SumLovelace(mins)
+	if err != nil {
+		return nil, err
+	}
+	if totalChange < required {
+		cause := newMinUTxOError(ErrCodeBelowMinUTxO, ErrBelowMinUTxO, fmt.Sprintf("change of %d Lovelace is below the combined minUTxO %d of %d outputs", totalChange, required, len(outputs)))
+		return nil, &InsufficientFundsError{Available: totalChange, Required: required, Cause: cause}
+	}
+
+	split, err := CalculateChangeSplit(totalChange-required, len(outputs))
+	if err != nil {
+		return nil, err
+	}
+	for i := range split {
+		split[i] += mins[i]
+	}
+	return split, nil
+}
+
+// EstimateChangeOutputSize estimates the serialized size of an ADA-only
+// change output to an address of type addrType, as added by coin
+// selection. Unknown address types are sized with the ByronAddressBytes
+// upper bound, so the estimate never falls short.
+//
+// Example:
+//
+//	n := fees.EstimateChangeOutputSize(fees.AddressBase)
+func EstimateChangeOutputSize(addrType AddressType) uint64 {
+	addrBytes, err := AddressBytesForType(addrType)
+	if err != nil {
+		addrBytes = ByronAddressBytes
+	}
+	return EstimateOutputBytes(OutputSize{AddressBytes: addrBytes})
+}
+
+// FeeIncrementForChangeOutput returns the fee increase, in Lovelace, from
+// adding an ADA-only change output to an address of type addrType:
+// MinFeeA * EstimateChangeOutputSize(addrType). Coin selection should only
+// add change when the leftover covers this increase plus the change
+// output's own minUTxO.
+//
+// Returns an error if p is invalid or addrType is unknown.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	inc, err := fees.FeeIncrementForChangeOutput(p, fees.AddressBase)
+func FeeIncrementForChangeOutput(p ProtocolParams, addrType AddressType) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	if _, err := AddressBytesForType(addrType); err != nil {
+		return 0, err
+	}
+	return marginalFee(p, 1, EstimateChangeOutputSize(addrType))
+}
+
+// InsufficientFundsError reports that the funds available to a transaction
+// cannot cover what it requires. CalculateChangeSplitWithMinUTxO returns
+// it when the change cannot cover its outputs' minUTxO, and coin selection
+// code can return it when the wallet's UTxOs fall short. Use errors.As to
+// recover the amounts.
+type InsufficientFundsError struct {
+	// Available is the Lovelace the selected inputs hold.
+	Available uint64
+
+	// Required is the Lovelace the transaction needs.
+	Required uint64
+
+	// Cause, if set, is the error for the requirement that was not met,
+	// such as a *MinUTxOError with ErrCodeBelowMinUTxO. It is returned by
+	// Unwrap and appended to the message.
+	Cause error
+}
+
+func (e *InsufficientFundsError) Error() string {
+	msg := fmt.Sprintf("fees: insufficient funds: have %s ADA, need %s ADA", ToADAString(e.Available), ToADAString(e.Required))
+	if e.Cause != nil {
+		msg += ": " + strings.TrimPrefix(e.Cause.Error(), "fees: ")
+	}
+	return msg
+}
+
+// Unwrap returns e.Cause, so that errors.Is(err, fees.ErrBelowMinUTxO)
+// matches a shortfall against minUTxO.
+func (e *InsufficientFundsError) Unwrap() error {
+	return e.Cause
+}
+
+// Shortfall returns Required - Available, the Lovelace still needed, or
+// zero if Available already covers Required.
+//
+// Example:
+//
+//	var ife *fees.InsufficientFundsError
+//	if errors.As(err, &ife) {
+//		fmt.Printf("add %s\n", fees.FormatADA(ife.Shortfall()))
+//	}
+func (e *InsufficientFundsError) Shortfall() uint64 {
+	if e.Available >= e.Required {
+		return 0
+	}
+	return e.Required - e.Available
+}
@@ -0,0 +1,162 @@
+package fees_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestCalculateChangeSplit(t *testing.T) {
+	tests := []struct {
+		name    string
+		total   uint64
+		n       int
+		want    []uint64
+		wantErr bool
+	}{
+		{"even", 9_000_000, 3, []uint64{3_000_000, 3_000_000, 3_000_000}, false},
+		{"remainder to last", 10_000_001, 3, []uint64{3_333_333, 3_333_333, 3_333_335}, false},
+		{"single", 42, 1, []uint64{42}, false},
+		{"zero change", 0, 2, []uint64{0, 0}, false},
+		{"zero outputs", 100, 0, nil, true},
+		{"negative outputs", 100, -1, nil, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.CalculateChangeSplit(tc.total, tc.n)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("split = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCalculateChangeSplitWithMinUTxO(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	adaOnly := fees.OutputSize{AddressBytes: 57}
+	nft := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 9}
+	outputs := []fees.OutputSize{adaOnly, nft}
+
+	minA, _ := fees.MinUTxO(p, adaOnly)
+	minN, _ := fees.MinUTxO(p, nft)
+
+	split, err := fees.CalculateChangeSplitWithMinUTxO(p, 5_000_001, outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	surplus := 5_000_001 - minA - minN
+	want := []uint64{minA + surplus/2, minN + surplus/2 + surplus%2}
+	if !reflect.DeepEqual(split, want) {
+		t.Errorf("split = %v, want %v", split, want)
+	}
+	if split[0]+split[1] != 5_000_001 {
+		t.Errorf("split sums to %d, want 5000001", split[0]+split[1])
+	}
+
+	exact, err := fees.CalculateChangeSplitWithMinUTxO(p, minA+minN, outputs)
+	if err != nil || !reflect.DeepEqual(exact, []uint64{minA, minN}) {
+		t.Errorf("exact split = %v, %v; want [%d %d]", exact, err, minA, minN)
+	}
+
+	_, err = fees.CalculateChangeSplitWithMinUTxO(p, minA+minN-1, outputs)
+	if !errors.Is(err, fees.ErrBelowMinUTxO) || !errors.Is(err, &fees.MinUTxOError{Code: fees.ErrCodeBelowMinUTxO}) {
+		t.Errorf("err = %v, want ErrBelowMinUTxO with ErrCodeBelowMinUTxO", err)
+	}
+	if !strings.Contains(err.Error(), "minUTxO") {
+		t.Errorf("err = %q, want the minUTxO context in the message", err)
+	}
+	var ife *fees.InsufficientFundsError
+	if !errors.As(err, &ife) {
+		t.Fatalf("err = %v, want *InsufficientFundsError", err)
+	}
+	if ife.Available != minA+minN-1 || ife.Required != minA+minN || ife.Shortfall() != 1 {
+		t.Errorf("InsufficientFundsError = %+v, Shortfall() = %d; want shortfall 1", ife, ife.Shortfall())
+	}
+	if _, err := fees.CalculateChangeSplitWithMinUTxO(p, 5_000_000, nil); err == nil {
+		t.Error("expected error for no outputs")
+	}
+}
+
+func TestFeeIncrementForChangeOutput(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	for _, addrType := range []fees.AddressType{
+		fees.AddressBase,
+		fees.AddressPointer,
+		fees.AddressEnterprise,
+		fees.AddressReward,
+		fees.AddressByron,
+	} {
+		t.Run(addrType.String(), func(t *testing.T) {
+			size := fees.EstimateChangeOutputSize(addrType)
+			inc, err := fees.FeeIncrementForChangeOutput(p, addrType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inc != p.MinFeeA*size {
+				t.Errorf("increment = %d, want MinFeeA * %d = %d", inc, size, p.MinFeeA*size)
+			}
+			minADA, err := fees.MinUTxOForAddressType(p, addrType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if inc >= minADA {
+				t.Errorf("fee increment %d is not below the change output's minUTxO %d", inc, minADA)
+			}
+		})
+	}
+
+	// A base address is larger than an enterprise address, so its change
+	// output costs more.
+	if fees.EstimateChangeOutputSize(fees.AddressBase) <= fees.EstimateChangeOutputSize(fees.AddressEnterprise) {
+		t.Error("base address change output should be larger than enterprise")
+	}
+	if got, want := fees.EstimateChangeOutputSize(fees.AddressType(99)), fees.EstimateChangeOutputSize(fees.AddressByron); got != want {
+		t.Errorf("unknown address type size = %d, want Byron bound %d", got, want)
+	}
+	if _, err := fees.FeeIncrementForChangeOutput(p, fees.AddressType(99)); !errors.Is(err, fees.ErrUnknownAddressType) {
+		t.Errorf("err = %v, want ErrUnknownAddressType", err)
+	}
+}
+
+func TestInsufficientFundsError(t *testing.T) {
+	tests := []struct {
+		name      string
+		available uint64
+		required  uint64
+		want      uint64
+	}{
+		{"short", 1_000_000, 2_500_000, 1_500_000},
+		{"exact", 2_500_000, 2_500_000, 0},
+		{"surplus", 3_000_000, 2_500_000, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := &fees.InsufficientFundsError{Available: tc.available, Required: tc.required}
+			if got := e.Shortfall(); got != tc.want {
+				t.Errorf("Shortfall() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+
+	var err error = fmt.Errorf("select: %w", &fees.InsufficientFundsError{Available: 1_000_000, Required: 2_500_000})
+	var ife *fees.InsufficientFundsError
+	if !errors.As(err, &ife) {
+		t.Fatalf("errors.As failed for %v", err)
+	}
+	if ife.Shortfall() != 1_500_000 {
+		t.Errorf("Shortfall() = %d, want 1500000", ife.Shortfall())
+	}
+	if want := "select: fees: insufficient funds: have 1.000000 ADA, need 2.500000 ADA"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
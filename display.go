@@ -0,0 +1,84 @@
+package fees
+
+import "strconv"
+
+// TxFeeDisplay holds a fee formatted for display in a wallet UI, in
+// Lovelace and ADA and optionally in a fiat currency. Fee calculation
+// itself stays in Lovelace; the fiat amount is for display only.
+type TxFeeDisplay struct {
+	// Lovelace is the fee in Lovelace.
+	Lovelace uint64
+
+	// ADA is the fee in ADA with six decimal places, without a unit, as
+	// returned by ToADAString (e.g. "0.170781").
+	ADA string
+
+	// FiatAmount is the fee in FiatCurrency, or nil if no fiat price was
+	// supplied.
+	FiatAmount *float64
+
+	// FiatCurrency is the currency code of FiatAmount, e.g. "USD".
+	FiatCurrency string
+}
+
+// NewTxFeeDisplay returns a TxFeeDisplay for a fee of lovelace, with the
+// Lovelace and ADA fields set and no fiat amount.
+//
+// Example:
+//
+//	d := fees.NewTxFeeDisplay(170_781) // d.ADA == "0.170781"
+func NewTxFeeDisplay(lovelace uint64) TxFeeDisplay {
+	return TxFeeDisplay{Lovelace: lovelace, ADA: ToADAString(lovelace)}
+}
+
+// WithFiat returns a copy of d with FiatAmount set to the fee's value at
+// adaFiatPrice units of currency per ADA.
+//
+// Example:
+//
+//	d := fees.NewTxFeeDisplay(170_781).WithFiat(0.75, "USD")
+//	fmt.Printf("%s ADA (%.4f %s)\n", d.ADA, *d.FiatAmount, d.FiatCurrency)
+//	// 0.170781 ADA (0.1281 USD)
+func (d TxFeeDisplay) WithFiat(adaFiatPrice float64, currency string) TxFeeDisplay {
+	amount := ToADA(d.Lovelace) * adaFiatPrice
+	d.FiatAmount = &amount
+	d.FiatCurrency = currency
+	return d
+}
+
+// FeePercentage returns fee as a percentage of totalValue, the Lovelace
+// the transaction moves, e.g. 0.017 for 0.017%. Above 100 means the fee
+// exceeds the value.
+//
+// Returns a *FeeError if totalValue is zero.
+//
+// Example:
+//
+//	pct, err := fees.FeePercentage(170_000, 1_000_000_000) // 0.017
+func FeePercentage(fee, totalValue uint64) (float64, error) {
+	if totalValue == 0 {
+		return 0, NewFeeError("totalValue must be greater than zero")
+	}
+	return float64(fee) / float64(totalValue) * 100, nil
+}
+
+// FormatFeeAsPercentage formats FeePercentage(fee, totalValue) to 6
+// significant figures with a percent sign and no exponent, for small
+// transactions where the relative fee is more meaningful than its amount.
+//
+// Returns a *FeeError if totalValue is zero.
+//
+// Example:
+//
+//	s, err := fees.FormatFeeAsPercentage(170_000, 1_000_000_000) // "0.017%"
+//	s, err := fees.FormatFeeAsPercentage(170_781, 1_000_000_000) // "0.0170781%"
+func FormatFeeAsPercentage(fee, totalValue uint64) (string, error) {
+	pct, err := FeePercentage(fee, totalValue)
+	if err != nil {
+		return "", err
+	}
+	// Round to 6 significant figures, then print the rounded value in
+	// plain decimal notation.
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(pct, 'g', 6, 64), 64)
+	return strconv.FormatFloat(rounded, 'f', -1, 64) + "%", nil
+}
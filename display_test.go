@@ -0,0 +1,86 @@
+package fees_test
+
+import (
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestTxFeeDisplay(t *testing.T) {
+	d := fees.NewTxFeeDisplay(170_781)
+	if d.Lovelace != 170_781 || d.ADA != "0.170781" {
+		t.Errorf("NewTxFeeDisplay = %+v", d)
+	}
+	if d.FiatAmount != nil || d.FiatCurrency != "" {
+		t.Errorf("fiat fields should be unset, got %v %q", d.FiatAmount, d.FiatCurrency)
+	}
+
+	usd := d.WithFiat(0.75, "USD")
+	if usd.FiatAmount == nil {
+		t.Fatal("WithFiat did not set FiatAmount")
+	}
+	if math.Abs(*usd.FiatAmount-0.12808575) > 1e-12 || usd.FiatCurrency != "USD" {
+		t.Errorf("WithFiat = %v %q, want 0.12808575 USD", *usd.FiatAmount, usd.FiatCurrency)
+	}
+	if d.FiatAmount != nil {
+		t.Error("WithFiat modified the receiver")
+	}
+
+	eur := usd.WithFiat(0.5, "EUR")
+	if *usd.FiatAmount == *eur.FiatAmount {
+		t.Error("copies should not share FiatAmount")
+	}
+}
+
+func TestFormatFeeAsPercentage(t *testing.T) {
+	tests := []struct {
+		name       string
+		fee, value uint64
+		want       string
+	}{
+		{"1000 ADA", 170_000, 1_000_000_000, "0.017%"},
+		{"six significant figures", 170_781, 1_000_000_000, "0.0170781%"},
+		{"rounded", 170_781, 3_000_000, "5.6927%"},
+		{"tiny", 170_000, 45_000_000_000_000_000, "0.000000000377778%"},
+		{"fee equals value", 170_000, 170_000, "100%"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.FormatFeeAsPercentage(tc.fee, tc.value)
+			if err != nil || got != tc.want {
+				t.Errorf("FormatFeeAsPercentage(%d, %d) = %q, %v; want %q", tc.fee, tc.value, got, err, tc.want)
+			}
+		})
+	}
+
+	if _, err := fees.FormatFeeAsPercentage(170_000, 0); err == nil {
+		t.Error("expected error for zero totalValue")
+	}
+}
+
+func TestFeePercentageTypicalRange(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	fee, err := fees.EstimateFee(p, 1, 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A simple payment moving tens to hundreds of ADA pays 0.01%–0.5%.
+	for _, ada := range []uint64{50, 100, 1_000} {
+		pct, err := fees.FeePercentage(fee, ada*fees.LovelacePerADA)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pct < 0.01 || pct > 0.5 {
+			t.Errorf("fee %d on %d ADA = %.4f%%, want within 0.01%%–0.5%%", fee, ada, pct)
+		}
+	}
+	if _, err := fees.FeePercentage(fee, 0); err == nil {
+		t.Error("expected error for zero totalValue")
+	}
+	if pct, _ := fees.FeePercentage(0, 1); pct != 0 {
+		t.Errorf("zero fee = %v, want 0", pct)
+	}
+}
@@ -0,0 +1,66 @@
+package fees
+
+import "time"
+
+// EpochSnapshot pairs a set of protocol parameters with the epoch in which
+// they were active, for auditing historical transactions against the fees
+// that applied at the time.
+type EpochSnapshot struct {
+	// Epoch is the epoch number the params were active in.
+	Epoch uint64
+
+	// Params are the protocol parameters in effect during Epoch.
+	Params ProtocolParams
+
+	// ActiveFrom is the start of the epoch (inclusive).
+	ActiveFrom time.Time
+
+	// ActiveTo is the end of the epoch (exclusive). The zero value means
+	// the snapshot is for the current epoch and has no end yet.
+	ActiveTo time.Time
+}
+
+// IsActiveAt reports whether t falls within the snapshot's active window:
+// ActiveFrom <= t < ActiveTo, or ActiveFrom <= t when ActiveTo is zero.
+//
+// Example:
+//
+//	if s.IsActiveAt(txTime) {
+//		fee, err := fees.MinFee(s.Params, txSize)
+//	}
+func (s EpochSnapshot) IsActiveAt(t time.Time) bool {
+	if t.Before(s.ActiveFrom) {
+		return false
+	}
+	return s.ActiveTo.IsZero() || t.Before(s.ActiveTo)
+}
+
+// EpochSnapshotStore looks up the EpochSnapshot for an epoch. Implement it
+// over a database or chain indexer to price historical transactions.
+type EpochSnapshotStore interface {
+	// Get returns the snapshot for epoch and true, or the zero snapshot and
+	// false if the store has none.
+	Get(epoch uint64) (EpochSnapshot, bool)
+}
+
+// MapEpochSnapshotStore is an in-memory EpochSnapshotStore keyed by epoch.
+// It is not safe for concurrent writes.
+//
+// Example:
+//
+//	store := fees.MapEpochSnapshotStore{}
+//	store.Put(fees.EpochSnapshot{Epoch: 540, Params: fees.DefaultMainnetParams()})
+//	s, ok := store.Get(540)
+type MapEpochSnapshotStore map[uint64]EpochSnapshot
+
+// Get implements EpochSnapshotStore.
+func (m MapEpochSnapshotStore) Get(epoch uint64) (EpochSnapshot, bool) {
+	s, ok := m[epoch]
+	return s, ok
+}
+
+// Put stores s under s.Epoch, replacing any existing snapshot for that
+// epoch.
+func (m MapEpochSnapshotStore) Put(s EpochSnapshot) {
+	m[s.Epoch] = s
+}
@@ -0,0 +1,58 @@
+package fees_test
+
+import (
+	"testing"
+	"time"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestEpochSnapshotIsActiveAt(t *testing.T) {
+	from := time.Date(2025, 3, 1, 21, 44, 51, 0, time.UTC)
+	to := from.Add(5 * 24 * time.Hour)
+
+	closed := fees.EpochSnapshot{Epoch: 540, ActiveFrom: from, ActiveTo: to}
+	open := fees.EpochSnapshot{Epoch: 541, ActiveFrom: to}
+
+	tests := []struct {
+		name string
+		s    fees.EpochSnapshot
+		at   time.Time
+		want bool
+	}{
+		{"before start", closed, from.Add(-time.Second), false},
+		{"at start", closed, from, true},
+		{"mid epoch", closed, from.Add(time.Hour), true},
+		{"at end is exclusive", closed, to, false},
+		{"open-ended current epoch", open, to.Add(365 * 24 * time.Hour), true},
+		{"open-ended before start", open, from, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.s.IsActiveAt(tc.at); got != tc.want {
+				t.Errorf("IsActiveAt(%v) = %v, want %v", tc.at, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMapEpochSnapshotStore(t *testing.T) {
+	var store fees.EpochSnapshotStore = fees.MapEpochSnapshotStore{
+		540: {Epoch: 540, Params: fees.DefaultMainnetParams()},
+	}
+
+	s, ok := store.Get(540)
+	if !ok || s.Params.MinFeeA != 44 {
+		t.Errorf("Get(540) = %+v, %v", s, ok)
+	}
+	if _, ok := store.Get(1); ok {
+		t.Error("Get(1) should report a missing epoch")
+	}
+
+	m := fees.MapEpochSnapshotStore{}
+	m.Put(fees.EpochSnapshot{Epoch: 7})
+	if _, ok := m.Get(7); !ok {
+		t.Error("Put did not store the snapshot")
+	}
+}
@@ -0,0 +1,78 @@
+package fees
+
+import "fmt"
+
+// EraLevel identifies a Cardano ledger era. Later eras compare greater, so
+// `level >= fees.EraAlonzo` asks "are Plutus features available?".
+type EraLevel int
+
+const (
+	// EraByron is the original federated era, before Shelley parameters.
+	EraByron EraLevel = iota
+
+	// EraShelley covers Shelley, Allegra, and Mary: linear fees and native
+	// tokens, but no Plutus.
+	EraShelley
+
+	// EraAlonzo introduced Plutus scripts and collateral.
+	EraAlonzo
+
+	// EraBabbage introduced inline datums, reference scripts, and
+	// coinsPerUTxOByte.
+	EraBabbage
+
+	// EraConway introduced on-chain governance.
+	EraConway
+)
+
+// String returns the era name, e.g. "Conway".
+func (e EraLevel) String() string {
+	switch e {
+	case EraByron:
+		return "Byron"
+	case EraShelley:
+		return "Shelley"
+	case EraAlonzo:
+		return "Alonzo"
+	case EraBabbage:
+		return "Babbage"
+	case EraConway:
+		return "Conway"
+	default:
+		return fmt.Sprintf("EraLevel(%d)", int(e))
+	}
+}
+
+// CompatibilityLevel infers the earliest era consistent with the fields set
+// in p, using parameters that were introduced in each era:
+//
+//   - GovActionDeposit non-zero: Conway
+//   - CoinsPerUTxOByte non-zero: Babbage
+//   - CollateralPercentage non-zero: Alonzo
+//   - MinFeeA non-zero: Shelley
+//   - otherwise: Byron
+//
+// The result is a lower bound: params fetched in a later era but with the
+// newer optional fields left unset report an earlier era. Use it to decide
+// which features the params can support.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	if p.CompatibilityLevel() >= fees.EraConway {
+//		// governance deposits are available
+//	}
+func (p ProtocolParams) CompatibilityLevel() EraLevel {
+	switch {
+	case p.GovActionDeposit != 0:
+		return EraConway
+	case p.CoinsPerUTxOByte != 0:
+		return EraBabbage
+	case p.CollateralPercentage != 0:
+		return EraAlonzo
+	case p.MinFeeA != 0:
+		return EraShelley
+	default:
+		return EraByron
+	}
+}
@@ -0,0 +1,44 @@
+package fees_test
+
+import (
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestCompatibilityLevel(t *testing.T) {
+	tests := []struct {
+		name string
+		p    fees.ProtocolParams
+		want fees.EraLevel
+	}{
+		{"mainnet defaults", fees.DefaultMainnetParams(), fees.EraConway},
+		{"no governance", fees.ProtocolParams{MinFeeA: 44, MinFeeB: 155381, CoinsPerUTxOByte: 4310, MaxTxSize: 16384}, fees.EraBabbage},
+		{"collateral only", fees.ProtocolParams{MinFeeA: 44, CollateralPercentage: 150}, fees.EraAlonzo},
+		{"linear fee only", fees.ProtocolParams{MinFeeA: 44, MinFeeB: 155381}, fees.EraShelley},
+		{"empty", fees.ProtocolParams{}, fees.EraByron},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.p.CompatibilityLevel(); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEraLevelString(t *testing.T) {
+	want := []string{"Byron", "Shelley", "Alonzo", "Babbage", "Conway"}
+	for i, name := range want {
+		if got := fees.EraLevel(i).String(); got != name {
+			t.Errorf("EraLevel(%d).String() = %q, want %q", i, got, name)
+		}
+	}
+	if got := fees.EraLevel(9).String(); got != "EraLevel(9)" {
+		t.Errorf("got %q", got)
+	}
+	if !(fees.EraConway > fees.EraBabbage && fees.EraBabbage > fees.EraAlonzo) {
+		t.Error("eras should be ordered")
+	}
+}
@@ -0,0 +1,874 @@
+package fees
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// Empirically-derived byte model used by the structural fee estimators,
+// calibrated against mainnet transactions:
+//
+//	base tx overhead:  ~200 bytes
+//	per input:         ~140 bytes (TxIn hash+index ~40 + VKey witness ~100)
+//	per output:        ~65 bytes (address + value)
+//	metadata overhead: ~250 bytes estimate (35-byte auxiliary_data_hash
+//	                   body field + ~215 bytes of auxiliary data)
+//	bootstrap witness: ~140 bytes (Byron inputs, instead of a VKey witness)
+const (
+	baseTxSize            uint64 = 200
+	txInBytes             uint64 = 40
+	vkeyWitnessBytes      uint64 = 100
+	bootstrapWitnessBytes uint64 = 140
+	bytesPerInput         uint64 = txInBytes + vkeyWitnessBytes // includes witness
+	bytesPerOutput        uint64 = 65
+	metadataSize          uint64 = 250
+
+	// refInputFieldBytes is the CBOR framing of the reference_inputs
+	// body field, paid once when any reference input is present.
+	refInputFieldBytes uint64 = 3
+)
+
+// structuralTxSize returns the byte-model size of a transaction with the
+// given key-witnessed inputs and outputs, and default-sized metadata.
+func structuralTxSize(numInputs, numOutputs uint64, hasMetadata bool) uint64 {
+	size := baseTxSize + bytesPerInput*numInputs + bytesPerOutput*numOutputs
+	if hasMetadata {
+		size += metadataSize
+	}
+	return size
+}
+
+// TxSizeWithReferenceScripts estimates the size of a transaction whose
+// scripts are supplied by reference (CIP-33). Each of the
+// numRefScriptInputs reference inputs adds only a TxIn reference (~40
+// bytes) and no witness or script bytes; the reference_inputs body field
+// adds ~3 bytes of CBOR framing when present.
+//
+// Example:
+//
+//	size := fees.TxSizeWithReferenceScripts(2, 2, 1, false) // 653
+func TxSizeWithReferenceScripts(numInputs, numOutputs, numRefScriptInputs uint64, hasMetadata bool) uint64 {
+	size := structuralTxSize(numInputs, numOutputs, hasMetadata)
+	if numRefScriptInputs > 0 {
+		size += refInputFieldBytes + txInBytes*numRefScriptInputs
+	}
+	return size
+}
+
+// TxSizeWithInlineScripts estimates the size of a transaction that carries
+// its scripts in the witness set, for comparison with
+// TxSizeWithReferenceScripts. totalScriptBytes is the combined serialized
+// size of all scripts; the witness set field adds ~3 bytes of CBOR framing
+// when present.
+//
+// Example:
+//
+//	size := fees.TxSizeWithInlineScripts(2, 2, 4_000, false) // 4613
+func TxSizeWithInlineScripts(numInputs, numOutputs uint64, totalScriptBytes uint64, hasMetadata bool) uint64 {
+	const scriptFieldBytes uint64 = 3
+	size := structuralTxSize(numInputs, numOutputs, hasMetadata)
+	if totalScriptBytes > 0 {
+		size += scriptFieldBytes + totalScriptBytes
+	}
+	return size
+}
+
+// FeeEstimateOptions describes the shape of a transaction for structural
+// fee estimation with EstimateFeeWithOptions. The zero value of each
+// optional field selects the library's default byte model.
+type FeeEstimateOptions struct {
+	// NumInputs is the number of key-witnessed inputs. Must be at least 1.
+	NumInputs uint64
+
+	// NumOutputs is the number of outputs. Must be at least 1.
+	NumOutputs uint64
+
+	// HasMetadata indicates whether the transaction carries auxiliary data.
+	HasMetadata bool
+
+	// MetadataBytes is the serialized size of the metadata, if known.
+	// When zero and HasMetadata is true, a 250-byte estimate is used. The
+	// first 35 bytes are attributed to the body's auxiliary_data_hash
+	// field and the rest to the auxiliary data; see
+	// EstimateTxAuxDataOnlyBytes.
+	MetadataBytes uint64
+
+	// HasPlutusScripts indicates that the transaction executes Plutus
+	// scripts. When true, ExUnits and ExecutionPrices must be set, and the
+	// script execution fee and the script_data_hash field are added to the
+	// estimate.
+	HasPlutusScripts bool
+
+	// ExUnits is the total execution budget declared by all redeemers.
+	ExUnits ExUnits
+
+	// ExecutionPrices are the executionUnitPrices protocol parameters.
+	ExecutionPrices ExecutionPrices
+
+	// RefScriptBytes is the combined size of scripts the transaction uses
+	// by reference (CIP-33). The bytes are priced with the Conway
+	// RefScriptFee, and one reference input is added to the size estimate;
+	// the scripts themselves are not part of the transaction.
+	RefScriptBytes uint64
+
+	// BootstrapWitnesses is how many of the NumInputs are spent from Byron
+	// addresses and so carry a ~140-byte bootstrap witness instead of a
+	// ~100-byte VKey witness. Must not exceed NumInputs.
+	BootstrapWitnesses uint64
+
+	// IncludeNetworkID indicates that the transaction body carries the
+	// optional network_id field.
+	IncludeNetworkID bool
+}
+
+// WithPlutusBudget returns a copy of o that executes Plutus scripts with
+// the given budget and prices.
+//
+// Example:
+//
+//	opts := fees.FeeEstimateOptions{NumInputs: 2, NumOutputs: 2}.
+//		WithPlutusBudget(units, fees.DefaultMainnetExecutionPrices())
+func (o FeeEstimateOptions) WithPlutusBudget(units ExUnits, prices ExecutionPrices) FeeEstimateOptions {
+	o.HasPlutusScripts = true
+	o.ExUnits = units
+	o.ExecutionPrices = prices
+	return o
+}
+
+// WithRefScriptBytes returns a copy of o whose scripts, totalling bytes,
+// are supplied by reference. The estimate then includes RefScriptFee for
+// bytes.
+func (o FeeEstimateOptions) WithRefScriptBytes(bytes uint64) FeeEstimateOptions {
+	o.RefScriptBytes = bytes
+	return o
+}
+
+// WithBootstrapWitnesses returns a copy of o in which n of the inputs are
+// spent from Byron addresses.
+func (o FeeEstimateOptions) WithBootstrapWitnesses(n uint64) FeeEstimateOptions {
+	o.BootstrapWitnesses = n
+	return o
+}
+
+// Validate checks opts for missing or inconsistent fields, so that
+// mistakes are caught before an estimate is attempted. All violations are
+// reported together in a single *ValidationError.
+//
+// Example:
+//
+//	opts := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2}
+//	if err := opts.Validate(); err != nil {
+//		log.Fatal(err)
+//	}
+func (o FeeEstimateOptions) Validate() error {
+	var violations []string
+	if o.NumInputs == 0 {
+		violations = append(violations, "NumInputs must be at least 1")
+	}
+	if o.NumOutputs == 0 {
+		violations = append(violations, "NumOutputs must be at least 1")
+	}
+	if o.MetadataBytes > 0 && !o.HasMetadata {
+		violations = append(violations, "MetadataBytes is set but HasMetadata is false")
+	}
+	if o.BootstrapWitnesses > o.NumInputs {
+		violations = append(violations, "BootstrapWitnesses must not exceed NumInputs")
+	}
+	if o.HasPlutusScripts {
+		if o.ExUnits.Memory == 0 {
+			violations = append(violations, "ExUnits.Memory must be non-zero for Plutus scripts")
+		}
+		if o.ExUnits.Steps == 0 {
+			violations = append(violations, "ExUnits.Steps must be non-zero for Plutus scripts")
+		}
+		if o.ExecutionPrices.PriceMemory.Denominator == 0 {
+			violations = append(violations, "ExecutionPrices.PriceMemory must have a non-zero denominator")
+		}
+		if o.ExecutionPrices.PriceSteps.Denominator == 0 {
+			violations = append(violations, "ExecutionPrices.PriceSteps must have a non-zero denominator")
+		}
+	}
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// EstimateFeeWithOptions provides a structural fee estimate for the
+// transaction described by opts. It generalizes EstimateFee; use it when
+// the metadata size is known or when more options are needed. When
+// opts.HasPlutusScripts is set, the ScriptDataHashBytes field is added to
+// the size and the ScriptFee for opts.ExUnits is added to the fee. When
+// opts.RefScriptBytes is set, the RefScriptFee for those bytes is added
+// too, which requires p.MinFeeRefScriptCostPerByte.
+//
+// Returns a *ParamError if p is invalid and a *ValidationError listing
+// every problem if opts is invalid.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.EstimateFeeWithOptions(p, fees.FeeEstimateOptions{
+//		NumInputs:     2,
+//		NumOutputs:    3,
+//		HasMetadata:   true,
+//		MetadataBytes: 600,
+//	})
+func EstimateFeeWithOptions(p ProtocolParams, opts FeeEstimateOptions) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return estimateFeeWithOptions(p, opts)
+}
+
+// estimateFeeWithOptions is EstimateFeeWithOptions without parameter
+// validation, for callers that have already validated p.
+func estimateFeeWithOptions(p ProtocolParams, opts FeeEstimateOptions) (uint64, error) {
+	if err := opts.Validate(); err != nil {
+		return 0, err
+	}
+	return feeForEstimatedSize(p, opts, estimatedTxBytes(opts))
+}
+
+// estimatedTxBytes is the total transaction size EstimateFeeWithOptions
+// charges for.
+func estimatedTxBytes(opts FeeEstimateOptions) uint64 {
+	return txEnvelopeBytes + EstimateTxBodyOnlyBytes(opts) + EstimateTxWitnessOnlyBytes(opts) + EstimateTxAuxDataOnlyBytes(opts)
+}
+
+// feeForEstimatedSize returns the fee for a transaction of size bytes
+// described by opts: the linear fee plus, for Plutus transactions, the
+// script execution fee, plus the Conway surcharge for any reference script
+// bytes. It agrees with RefScriptTransactionFee for the same size.
+func feeForEstimatedSize(p ProtocolParams, opts FeeEstimateOptions, size uint64) (uint64, error) {
+	fee, err := minFee(p, size)
+	if err != nil {
+		return 0, err
+	}
+	if opts.HasPlutusScripts {
+		scriptFee, err := ScriptFee(opts.ExUnits, opts.ExecutionPrices)
+		if err != nil {
+			return 0, err
+		}
+		if fee, err = AddLovelace(fee, scriptFee); err != nil {
+			return 0, err
+		}
+	}
+	if opts.RefScriptBytes > 0 {
+		refFee, err := refScriptFee(p, opts.RefScriptBytes)
+		if err != nil {
+			return 0, err
+		}
+		if fee, err = AddLovelace(fee, refFee); err != nil {
+			return 0, err
+		}
+	}
+	return fee, nil
+}
+
+// txEnvelopeBytes is the CBOR overhead of the outermost transaction array,
+// [body, witness_set, is_valid, auxiliary_data], outside the body and
+// witness set themselves. It is part of the baseTxSize overhead.
+const txEnvelopeBytes uint64 = 5
+
+// auxDataHashFieldBytes is the auxiliary_data_hash body field: the body
+// key, a 2-byte byte string header, and the 32-byte hash.
+const auxDataHashFieldBytes uint64 = 35
+
+// EstimateTxBodyOnlyBytes estimates the bytes of the transaction body
+// described by opts: its share of the base overhead, the TxIn references,
+// the outputs, and the optional body fields. The auxiliary data (metadata)
+// sits outside the body, which carries only the 35-byte hash field taken
+// from the metadata estimate; see EstimateTxAuxDataOnlyBytes. Witnesses
+// are excluded; see EstimateTxWitnessOnlyBytes.
+//
+// The total transaction size used by EstimateFeeWithOptions is
+//
+//	5 (outer array) + EstimateTxBodyOnlyBytes + EstimateTxWitnessOnlyBytes
+//	+ EstimateTxAuxDataOnlyBytes
+//
+// Example:
+//
+//	opts := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2}
+//	fees.EstimateTxBodyOnlyBytes(opts) // 195 + 40 + 130 = 365
+func EstimateTxBodyOnlyBytes(opts FeeEstimateOptions) uint64 {
+	size := baseTxSize - txEnvelopeBytes + txInBytes*opts.NumInputs + bytesPerOutput*opts.NumOutputs
+	hashField, _ := metadataSplit(opts)
+	size += hashField
+	if opts.RefScriptBytes > 0 {
+		size += refInputFieldBytes + txInBytes
+	}
+	if opts.HasPlutusScripts {
+		size += ScriptDataHashBytes()
+	}
+	if opts.IncludeNetworkID {
+		size = EstimateTxSizeWithNetworkID(size)
+	}
+	return size
+}
+
+// EstimateTxAuxDataOnlyBytes estimates the bytes of the auxiliary data
+// (metadata) element of the transaction described by opts. It is not part
+// of the transaction body. The metadata estimate (MetadataBytes, or 250
+// bytes when HasMetadata is set without a size) is split between the
+// body's 35-byte auxiliary_data_hash field and this element, so the total
+// size is unchanged by the split.
+//
+// Example:
+//
+//	opts := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2, HasMetadata: true, MetadataBytes: 600}
+//	fees.EstimateTxAuxDataOnlyBytes(opts) // 600 - 35 = 565
+func EstimateTxAuxDataOnlyBytes(opts FeeEstimateOptions) uint64 {
+	_, aux := metadataSplit(opts)
+	return aux
+}
+
+// metadataSplit divides the metadata estimate for opts into the body's
+// auxiliary_data_hash field and the auxiliary data element.
+func metadataSplit(opts FeeEstimateOptions) (hashField, auxData uint64) {
+	if !opts.HasMetadata {
+		return 0, 0
+	}
+	total := opts.MetadataBytes
+	if total == 0 {
+		total = metadataSize
+	}
+	hashField = min(auxDataHashFieldBytes, total)
+	return hashField, total - hashField
+}
+
+// EstimateTxWitnessOnlyBytes estimates the bytes of the witness set for
+// the transaction described by opts: one VKey witness per input, with
+// BootstrapWitnesses of them replaced by larger bootstrap witnesses.
+//
+// Example:
+//
+//	opts := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2}
+//	fees.EstimateTxWitnessOnlyBytes(opts) // 100
+func EstimateTxWitnessOnlyBytes(opts FeeEstimateOptions) uint64 {
+	return vkeyWitnessBytes*opts.NumInputs + (bootstrapWitnessBytes-vkeyWitnessBytes)*opts.BootstrapWitnesses
+}
+
+// TxSizeClass is a coarse transaction size bucket, for showing a fee range
+// before a transaction has been built. See EstimateFeeBySizeClass.
+type TxSizeClass int
+
+const (
+	// TxClassSmall is 200–500 bytes: a simple ADA payment with change.
+	TxClassSmall TxSizeClass = iota
+
+	// TxClassMedium is 501–2,000 bytes: multi-asset transfers, several
+	// inputs, or metadata.
+	TxClassMedium
+
+	// TxClassLarge is 2,001–8,000 bytes: mints, batched payments, and
+	// Plutus transactions.
+	TxClassLarge
+
+	// TxClassMaximum is 8,001 bytes up to MaxTxSize.
+	TxClassMaximum
+)
+
+// String returns the class name, e.g. "small".
+func (c TxSizeClass) String() string {
+	switch c {
+	case TxClassSmall:
+		return "small"
+	case TxClassMedium:
+		return "medium"
+	case TxClassLarge:
+		return "large"
+	case TxClassMaximum:
+		return "maximum"
+	default:
+		return fmt.Sprintf("TxSizeClass(%d)", int(c))
+	}
+}
+
+// EstimateFeeBySizeClass returns the range of minimum fees for transactions
+// in class, for quick UI display before the transaction is constructed.
+// The byte boundaries of each class are documented on its constant; the
+// upper bound of TxClassMaximum is p.MaxTxSize.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	lo, hi, err := fees.EstimateFeeBySizeClass(p, fees.TxClassSmall)
+//	// lo = MinFee(p, 200), hi = MinFee(p, 500)
+func EstimateFeeBySizeClass(p ProtocolParams, class TxSizeClass) (lo, hi uint64, err error) {
+	if err := p.Validate(); err != nil {
+		return 0, 0, err
+	}
+
+	var loSize, hiSize uint64
+	switch class {
+	case TxClassSmall:
+		loSize, hiSize = 200, 500
+	case TxClassMedium:
+		loSize, hiSize = 501, 2_000
+	case TxClassLarge:
+		loSize, hiSize = 2_001, 8_000
+	case TxClassMaximum:
+		loSize, hiSize = 8_001, p.MaxTxSize
+	default:
+		return 0, 0, NewFeeError(fmt.Sprintf("unknown size class %d", int(class)))
+	}
+	if hiSize > p.MaxTxSize {
+		hiSize = p.MaxTxSize
+	}
+	if loSize > hiSize {
+		return 0, 0, NewFeeError(fmt.Sprintf("size class %s starts above MaxTxSize %d", class, p.MaxTxSize))
+	}
+
+	if lo, err = minFee(p, loSize); err != nil {
+		return 0, 0, err
+	}
+	if hi, err = minFee(p, hiSize); err != nil {
+		return 0, 0, err
+	}
+	return lo, hi, nil
+}
+
+// TxInputByteEstimate returns the number of bytes one key-witnessed input
+// adds to a transaction in the structural byte model: ~40 bytes for the
+// TxIn reference plus ~100 bytes for its VKey witness.
+//
+// Example:
+//
+//	fees.TxInputByteEstimate() // 140
+func TxInputByteEstimate() uint64 {
+	return bytesPerInput
+}
+
+// TxVKeyWitnessByteEstimate returns the number of bytes one VKey witness
+// adds to a transaction's witness set: a 32-byte verification key and a
+// 64-byte Ed25519 signature plus CBOR framing, ~100 bytes.
+//
+// Example:
+//
+//	fees.TxVKeyWitnessByteEstimate() // 100
+func TxVKeyWitnessByteEstimate() uint64 {
+	return vkeyWitnessBytes
+}
+
+// FeeForOneVKeyWitness returns the fee increase, in Lovelace, from adding
+// exactly one more VKey witness to a transaction:
+// MinFeeA * TxVKeyWitnessByteEstimate(). Use it when witnesses are added one
+// at a time, as with hardware wallets.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.FeeForOneVKeyWitness(p) // 44 * 100 = 4,400
+func FeeForOneVKeyWitness(p ProtocolParams) (uint64, error) {
+	return FeeForVKeyWitnesses(p, 1)
+}
+
+// FeeForVKeyWitnesses returns the fee increase, in Lovelace, from adding n
+// VKey witnesses to a transaction. The fixed MinFeeB term is not included.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.FeeForVKeyWitnesses(p, 3) // 44 * 300 = 13,200
+func FeeForVKeyWitnesses(p ProtocolParams, n uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return marginalFee(p, n, TxVKeyWitnessByteEstimate())
+}
+
+// marginalFee returns MinFeeA * n * bytesEach, or a *FeeError if the
+// product overflows uint64. It does not validate p.
+func marginalFee(p ProtocolParams, n, bytesEach uint64) (uint64, error) {
+	hi, bytes := bits.Mul64(n, bytesEach)
+	if hi != 0 {
+		return 0, NewFeeError(fmt.Sprintf("%d × %d bytes overflows uint64", n, bytesEach))
+	}
+	hi, fee := bits.Mul64(p.MinFeeA, bytes)
+	if hi != 0 {
+		return 0, NewFeeError(fmt.Sprintf("fee for %d bytes overflows uint64", bytes))
+	}
+	return fee, nil
+}
+
+// MarginalFeeForInput returns the fee increase, in Lovelace, from adding one
+// more key-witnessed input to a transaction: MinFeeA * TxInputByteEstimate().
+// The fixed MinFeeB term is not included, so the result can be summed
+// across inputs in greedy coin selection. Returns a *FeeError if the
+// result overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.MarginalFeeForInput(p) // 44 * 140 = 6,160
+func MarginalFeeForInput(p ProtocolParams) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return marginalFee(p, 1, TxInputByteEstimate())
+}
+
+// MarginalFeeForOutput returns the fee increase, in Lovelace, from adding
+// out to a transaction: MinFeeA * EstimateOutputBytes(out). The fixed
+// MinFeeB term is not included. Returns a *FeeError if the result
+// overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.MarginalFeeForOutput(p, fees.OutputSize{AddressBytes: 57})
+//	// cost = 44 * 76 = 3,344
+func MarginalFeeForOutput(p ProtocolParams, out OutputSize) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return marginalFee(p, 1, EstimateOutputBytes(out))
+}
+
+// MinFeeUpperBoundWithWitnesses returns the worst-case minimum fee for a
+// transaction spending numInputs inputs when each input may need up to
+// maxWitnessesPerInput VKey witnesses, as with a native script requiring
+// M signers. It assumes a single output and no metadata, and does not
+// include the native script bytes themselves. The result is an upper bound
+// for auditing and dispute resolution, not the expected fee.
+//
+//	size = 200 + numInputs * (40 + 100*maxWitnessesPerInput) + 65
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeUpperBoundWithWitnesses(p, 2, 3)
+//	// size = 200 + 2*(40 + 300) + 65 = 945 bytes
+func MinFeeUpperBoundWithWitnesses(p ProtocolParams, numInputs, maxWitnessesPerInput uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	if numInputs == 0 {
+		return 0, NewFeeError("numInputs must be at least 1")
+	}
+	if maxWitnessesPerInput == 0 {
+		return 0, NewFeeError("maxWitnessesPerInput must be at least 1")
+	}
+
+	hi, witnessBytes := bits.Mul64(maxWitnessesPerInput, vkeyWitnessBytes)
+	perInput, carry := bits.Add64(witnessBytes, txInBytes, 0)
+	if hi != 0 || carry != 0 {
+		return 0, NewFeeError("witness bytes overflow uint64")
+	}
+	hi, inputBytes := bits.Mul64(numInputs, perInput)
+	size, carry := bits.Add64(inputBytes, baseTxSize+bytesPerOutput, 0)
+	if hi != 0 || carry != 0 {
+		return 0, NewFeeError("transaction size overflows uint64")
+	}
+	return minFee(p, size)
+}
+
+// MinFeeUpperBoundForScriptTx returns the worst-case fee for a Plutus
+// transaction with the given inputs, outputs and declared execution budget,
+// priced at the network's execution prices. Every input is counted as
+// key-witnessed and the default metadata allowance and script_data_hash
+// field are included, so the result is an upper bound rather than the
+// expected fee. Script bytes are not included; add them with
+// MinFeeWithPadding for inline scripts.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeUpperBoundForScriptTx(p, 2, 2, fees.ExUnits{
+//		Memory: 500_000,
+//		Steps:  200_000_000,
+//	}, fees.DefaultMainnetExecutionPrices())
+func MinFeeUpperBoundForScriptTx(p ProtocolParams, numInputs, numOutputs uint64, exUnits ExUnits, prices ExecutionPrices) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return estimateFeeWithOptions(p, FeeEstimateOptions{
+		NumInputs:        numInputs,
+		NumOutputs:       numOutputs,
+		HasMetadata:      true,
+		HasPlutusScripts: true,
+		ExUnits:          exUnits,
+		ExecutionPrices:  prices,
+	})
+}
+
+// TxOutputByteEstimateADAOnly returns the number of bytes one ADA-only
+// output adds to a transaction in the structural byte model used by
+// EstimateFee: ~65 bytes. MarginalFeeForOutput instead sizes a specific
+// OutputSize with EstimateOutputBytes.
+//
+// Example:
+//
+//	fees.TxOutputByteEstimateADAOnly() // 65
+func TxOutputByteEstimateADAOnly() uint64 {
+	return bytesPerOutput
+}
+
+// BytesForNInputs returns the bytes n key-witnessed inputs add to a
+// transaction: n * TxInputByteEstimate().
+//
+// Example:
+//
+//	fees.BytesForNInputs(3) // 420
+func BytesForNInputs(n uint64) uint64 {
+	return n * TxInputByteEstimate()
+}
+
+// BytesForNOutputsADAOnly returns the bytes n ADA-only outputs add to a
+// transaction: n * TxOutputByteEstimateADAOnly().
+//
+// Example:
+//
+//	fees.BytesForNOutputsADAOnly(2) // 130
+func BytesForNOutputsADAOnly(n uint64) uint64 {
+	return n * TxOutputByteEstimateADAOnly()
+}
+
+// MarginalFeeForNInputs returns the fee increase, in Lovelace, from adding
+// n key-witnessed inputs: MinFeeA * BytesForNInputs(n). Returns a
+// *FeeError if the result overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.MarginalFeeForNInputs(p, 3) // 44 * 420 = 18,480
+func MarginalFeeForNInputs(p ProtocolParams, n uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return marginalFee(p, n, TxInputByteEstimate())
+}
+
+// MarginalFeeForNOutputs returns the fee increase, in Lovelace, from adding
+// n ADA-only outputs: MinFeeA * BytesForNOutputsADAOnly(n). Returns a
+// *FeeError if the result overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.MarginalFeeForNOutputs(p, 2) // 44 * 130 = 5,720
+func MarginalFeeForNOutputs(p ProtocolParams, n uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return marginalFee(p, n, TxOutputByteEstimateADAOnly())
+}
+
+// RecalculateFeeAfterChangingOutput returns the fee after an output grows
+// by outputSizeDeltaBytes, as happens when Lovelace is added to a change
+// output to meet its minUTxO: MinFee(p, prevTxSizeBytes +
+// outputSizeDeltaBytes). The fee is recomputed from the size rather than
+// adjusted from prevFee, so that padding in prevFee does not accumulate
+// across iterations.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.RecalculateFeeAfterChangingOutput(p, prevFee, 450, 4)
+func RecalculateFeeAfterChangingOutput(p ProtocolParams, prevFee, prevTxSizeBytes, outputSizeDeltaBytes uint64) (uint64, error) {
+	size, carry := bits.Add64(prevTxSizeBytes, outputSizeDeltaBytes, 0)
+	if carry != 0 {
+		return 0, NewFeeError("transaction size overflows uint64")
+	}
+	return MinFee(p, size)
+}
+
+// maxFeeIterations bounds ConvergeFee; the fee normally stabilizes in two
+// or three iterations.
+const maxFeeIterations = 10
+
+// ConvergeFee computes a transaction fee by fixed-point iteration. The fee
+// is itself encoded in the transaction body, so its size depends on its
+// value: each iteration adds the CBOR size of the previous fee to the
+// structural size and recomputes the fee, until it no longer changes.
+//
+// The transaction has numInputs key-witnessed inputs and numOutputs
+// outputs, the first len(outputs) of which are sized with
+// EstimateOutputBytes and the rest as ADA-only outputs. It returns the
+// fee and the number of iterations taken.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, iterations, err := fees.ConvergeFee(p, 2, 2, []fees.OutputSize{
+//		{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 9},
+//	})
+func ConvergeFee(p ProtocolParams, numInputs, numOutputs uint64, outputs []OutputSize) (fee uint64, iterations int, err error) {
+	if err := p.Validate(); err != nil {
+		return 0, 0, err
+	}
+	if numInputs == 0 {
+		return 0, 0, NewFeeError("numInputs must be at least 1")
+	}
+	if numOutputs == 0 {
+		return 0, 0, NewFeeError("numOutputs must be at least 1")
+	}
+	if uint64(len(outputs)) > numOutputs {
+		return 0, 0, NewFeeError(fmt.Sprintf("%d outputs described but numOutputs is %d", len(outputs), numOutputs))
+	}
+
+	size := baseTxSize + bytesPerInput*numInputs + bytesPerOutput*(numOutputs-uint64(len(outputs)))
+	for _, out := range outputs {
+		var carry uint64
+		size, carry = bits.Add64(size, EstimateOutputBytes(out), 0)
+		if carry != 0 {
+			return 0, 0, NewFeeError("transaction size overflows uint64")
+		}
+	}
+
+	for iterations < maxFeeIterations {
+		iterations++
+		next, err := minFee(p, size+cborUintBytes(fee))
+		if err != nil {
+			return 0, iterations, err
+		}
+		if next == fee {
+			return fee, iterations, nil
+		}
+		fee = next
+	}
+	return 0, iterations, NewFeeError(fmt.Sprintf("fee did not converge after %d iterations", maxFeeIterations))
+}
+
+// BatchFeeEstimate estimates the fee for each transaction in configs,
+// returning a slice of fees parallel to configs. The params are validated
+// once for the whole batch. It stops at the first failing config and
+// returns its error; use BatchFeeEstimateAll to collect every error.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	batch, err := fees.BatchFeeEstimate(p, []fees.FeeEstimateOptions{
+//		{NumInputs: 1, NumOutputs: 2},
+//		{NumInputs: 3, NumOutputs: 1, HasMetadata: true},
+//	})
+func BatchFeeEstimate(p ProtocolParams, configs []FeeEstimateOptions) ([]uint64, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	out := make([]uint64, len(configs))
+	for i, opts := range configs {
+		fee, err := estimateFeeWithOptions(p, opts)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = fee
+	}
+	return out, nil
+}
+
+// BatchFeeEstimateAll is like BatchFeeEstimate but estimates every config
+// regardless of failures. It returns two slices parallel to configs: the
+// fees (zero where estimation failed) and the errors (nil where it
+// succeeded). If the params themselves are invalid, every entry carries
+// that error.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	batch, errs := fees.BatchFeeEstimateAll(p, configs)
+//	for i, err := range errs {
+//		if err != nil {
+//			log.Printf("config %d: %v", i, err)
+//		}
+//	}
+func BatchFeeEstimateAll(p ProtocolParams, configs []FeeEstimateOptions) ([]uint64, []error) {
+	out := make([]uint64, len(configs))
+	errs := make([]error, len(configs))
+	if err := p.Validate(); err != nil {
+		for i := range errs {
+			errs[i] = err
+		}
+		return out, errs
+	}
+	for i, opts := range configs {
+		out[i], errs[i] = estimateFeeWithOptions(p, opts)
+	}
+	return out, errs
+}
+
+// ValidationError is returned by Validate methods that check several
+// fields at once. It lists every violation found rather than just the
+// first.
+type ValidationError struct {
+	// Violations describes each problem found, one per entry.
+	Violations []string
+}
+
+func (e *ValidationError) Error() string {
+	return "fees: validation failed: " + strings.Join(e.Violations, "; ")
+}
+
+// FeeEstimationUncertainty is a structural fee estimate with bounds that
+// reflect the error of the byte model.
+type FeeEstimationUncertainty struct {
+	// EstimatedFee is the EstimateFeeWithOptions result.
+	EstimatedFee uint64
+
+	// LowerBound is the fee if the transaction is 10% smaller than
+	// estimated.
+	LowerBound uint64
+
+	// UpperBound is the fee if the transaction is 10% larger than
+	// estimated, capped at MaxTxSize.
+	UpperBound uint64
+
+	// PercentUncertainty is how far UpperBound lies above EstimatedFee, as
+	// a percentage of EstimatedFee.
+	PercentUncertainty float64
+}
+
+// RecommendedFee returns UpperBound, the safe fee to budget for before the
+// transaction is serialized and its exact fee known.
+//
+// Example:
+//
+//	u, err := fees.EstimateFeeWithUncertainty(p, opts)
+//	reserve := u.RecommendedFee()
+func (u FeeEstimationUncertainty) RecommendedFee() uint64 {
+	return u.UpperBound
+}
+
+// EstimateFeeWithUncertainty returns the EstimateFeeWithOptions estimate
+// for opts with bounds computed at ±10% of the estimated transaction size.
+// The upper size is capped at p.MaxTxSize, since no larger transaction is
+// valid. The script execution fee and reference script fee, which do not
+// depend on the transaction size, are included in all three fees.
+//
+// Returns an error under the same conditions as EstimateFeeWithOptions.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	u, err := fees.EstimateFeeWithUncertainty(p, fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2})
+//	// size 470 bytes: bounds from 423 to 517 bytes
+func EstimateFeeWithUncertainty(p ProtocolParams, opts FeeEstimateOptions) (FeeEstimationUncertainty, error) {
+	if err := p.Validate(); err != nil {
+		return FeeEstimationUncertainty{}, err
+	}
+	estimated, err := estimateFeeWithOptions(p, opts)
+	if err != nil {
+		return FeeEstimationUncertainty{}, err
+	}
+
+	size := estimatedTxBytes(opts)
+	lowerSize := max(size-size/10, 1)
+	upperSize := min(size+(size+9)/10, p.MaxTxSize)
+
+	lower, err := feeForEstimatedSize(p, opts, lowerSize)
+	if err != nil {
+		return FeeEstimationUncertainty{}, err
+	}
+	upper, err := feeForEstimatedSize(p, opts, upperSize)
+	if err != nil {
+		return FeeEstimationUncertainty{}, err
+	}
+	return FeeEstimationUncertainty{
+		EstimatedFee:       estimated,
+		LowerBound:         lower,
+		UpperBound:         upper,
+		PercentUncertainty: float64(upper-estimated) / float64(estimated) * 100,
+	}, nil
+}
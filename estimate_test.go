@@ -0,0 +1,723 @@
+package fees_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestEstimateFeeWithOptions(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name    string
+		opts    fees.FeeEstimateOptions
+		wantFee uint64
+		wantErr bool
+	}{
+		{
+			name:    "1in 1out",
+			opts:    fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1},
+			wantFee: 44*(200+140+65) + 155381,
+		},
+		{
+			name:    "default metadata size",
+			opts:    fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, HasMetadata: true},
+			wantFee: 44*(200+140+65+250) + 155381,
+		},
+		{
+			name:    "explicit metadata size",
+			opts:    fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, HasMetadata: true, MetadataBytes: 600},
+			wantFee: 44*(200+140+65+600) + 155381,
+		},
+		{
+			name:    "0 inputs",
+			opts:    fees.FeeEstimateOptions{NumOutputs: 1},
+			wantErr: true,
+		},
+		{
+			name:    "0 inputs and 0 outputs",
+			opts:    fees.FeeEstimateOptions{},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.EstimateFeeWithOptions(p, tc.opts)
+			if tc.wantErr {
+				var ve *fees.ValidationError
+				if !errors.As(err, &ve) {
+					t.Fatalf("err = %v, want *ValidationError", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.wantFee {
+				t.Errorf("got %d, want %d", got, tc.wantFee)
+			}
+		})
+	}
+}
+
+func TestBatchFeeEstimate(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	configs := []fees.FeeEstimateOptions{
+		{NumInputs: 1, NumOutputs: 1},
+		{NumInputs: 2, NumOutputs: 2, HasMetadata: true},
+	}
+
+	got, err := fees.BatchFeeEstimate(p, configs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(configs) {
+		t.Fatalf("got %d fees, want %d", len(got), len(configs))
+	}
+	for i, opts := range configs {
+		want, _ := fees.EstimateFee(p, opts.NumInputs, opts.NumOutputs, opts.HasMetadata)
+		if got[i] != want {
+			t.Errorf("fee[%d] = %d, want %d", i, got[i], want)
+		}
+	}
+
+	// Short-circuits on the first invalid config.
+	configs = append(configs, fees.FeeEstimateOptions{NumInputs: 1})
+	if _, err := fees.BatchFeeEstimate(p, configs); err == nil {
+		t.Error("expected error for config with zero outputs")
+	}
+
+	var pe *fees.ParamError
+	if _, err := fees.BatchFeeEstimate(fees.ProtocolParams{}, configs); !errors.As(err, &pe) {
+		t.Errorf("expected *ParamError, got %T", err)
+	}
+}
+
+func TestBatchFeeEstimateAll(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	configs := []fees.FeeEstimateOptions{
+		{NumInputs: 1, NumOutputs: 1},
+		{NumInputs: 0, NumOutputs: 1},
+		{NumInputs: 2, NumOutputs: 2},
+	}
+
+	got, errs := fees.BatchFeeEstimateAll(p, configs)
+	if len(got) != 3 || len(errs) != 3 {
+		t.Fatalf("got %d fees and %d errors, want 3 and 3", len(got), len(errs))
+	}
+	if errs[0] != nil || errs[2] != nil {
+		t.Errorf("unexpected errors: %v, %v", errs[0], errs[2])
+	}
+	if errs[1] == nil {
+		t.Error("expected error for zero-input config")
+	}
+	if got[1] != 0 {
+		t.Errorf("failed config fee = %d, want 0", got[1])
+	}
+	if got[0] == 0 || got[2] <= got[0] {
+		t.Errorf("unexpected fees: %v", got)
+	}
+
+	_, errs = fees.BatchFeeEstimateAll(fees.ProtocolParams{}, configs)
+	for i, err := range errs {
+		if err == nil {
+			t.Errorf("errs[%d] = nil, want param error", i)
+		}
+	}
+}
+
+func TestFeeEstimateOptionsValidate(t *testing.T) {
+	prices := fees.ExecutionPrices{
+		PriceMemory: fees.Rational{Numerator: 577, Denominator: 10000},
+		PriceSteps:  fees.Rational{Numerator: 721, Denominator: 10000000},
+	}
+
+	tests := []struct {
+		name           string
+		opts           fees.FeeEstimateOptions
+		wantViolations int
+	}{
+		{"valid", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1}, 0},
+		{"valid metadata", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, HasMetadata: true, MetadataBytes: 300}, 0},
+		{"zero inputs", fees.FeeEstimateOptions{NumOutputs: 1}, 1},
+		{"zero inputs and outputs", fees.FeeEstimateOptions{}, 2},
+		{"metadata bytes without flag", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, MetadataBytes: 300}, 1},
+		{
+			"valid plutus",
+			fees.FeeEstimateOptions{
+				NumInputs: 1, NumOutputs: 1, HasPlutusScripts: true,
+				ExUnits: fees.ExUnits{Memory: 1, Steps: 1}, ExecutionPrices: prices,
+			},
+			0,
+		},
+		{"plutus without budget or prices", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, HasPlutusScripts: true}, 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.wantViolations == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var ve *fees.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("expected *ValidationError, got %T", err)
+			}
+			if len(ve.Violations) != tc.wantViolations {
+				t.Errorf("got %d violations %q, want %d", len(ve.Violations), ve.Violations, tc.wantViolations)
+			}
+		})
+	}
+}
+
+func TestEstimateFeeWithOptionsPlutus(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	base := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1}
+	plutus := base
+	plutus.HasPlutusScripts = true
+	plutus.ExUnits = fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000}
+	plutus.ExecutionPrices = fees.ExecutionPrices{
+		PriceMemory: fees.Rational{Numerator: 577, Denominator: 10000},
+		PriceSteps:  fees.Rational{Numerator: 721, Denominator: 10000000},
+	}
+
+	baseFee, err := fees.EstimateFeeWithOptions(p, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plutusFee, err := fees.EstimateFeeWithOptions(p, plutus)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// script_data_hash adds 34 bytes on top of the execution fee.
+	if want := baseFee + 44*34 + 93_750; plutusFee != want {
+		t.Errorf("got %d, want %d", plutusFee, want)
+	}
+
+	inconsistent := base
+	inconsistent.MetadataBytes = 100
+	if _, err := fees.EstimateFeeWithOptions(p, inconsistent); err == nil {
+		t.Error("expected error for MetadataBytes without HasMetadata")
+	}
+}
+
+func TestMarginalFeeForInput(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	got, err := fees.MarginalFeeForInput(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := p.MinFeeA * fees.TxInputByteEstimate(); got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+
+	// Adding an input to an estimate raises the fee by exactly the marginal cost.
+	one, _ := fees.EstimateFee(p, 1, 1, false)
+	two, _ := fees.EstimateFee(p, 2, 1, false)
+	if two-one != got {
+		t.Errorf("EstimateFee delta = %d, want %d", two-one, got)
+	}
+
+	if _, err := fees.MarginalFeeForInput(fees.ProtocolParams{}); err == nil {
+		t.Error("expected error for zero params")
+	}
+
+	huge := p
+	huge.MinFeeA = math.MaxUint64 / 100
+	var fe *fees.FeeError
+	if _, err := fees.MarginalFeeForInput(huge); !errors.As(err, &fe) {
+		t.Errorf("err = %v, want *FeeError on overflow", err)
+	}
+}
+
+func TestMarginalFeeForOutput(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 32}
+
+	got, err := fees.MarginalFeeForOutput(p, out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := p.MinFeeA * fees.EstimateOutputBytes(out); got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+
+	if _, err := fees.MarginalFeeForOutput(fees.ProtocolParams{}, out); err == nil {
+		t.Error("expected error for zero params")
+	}
+
+	huge := p
+	huge.MinFeeA = math.MaxUint64 / 100
+	var fe *fees.FeeError
+	if _, err := fees.MarginalFeeForOutput(huge, out); !errors.As(err, &fe) {
+		t.Errorf("err = %v, want *FeeError on overflow", err)
+	}
+}
+
+func TestEstimateFeeBySizeClass(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		class          fees.TxSizeClass
+		loSize, hiSize uint64
+		wantName       string
+	}{
+		{fees.TxClassSmall, 200, 500, "small"},
+		{fees.TxClassMedium, 501, 2_000, "medium"},
+		{fees.TxClassLarge, 2_001, 8_000, "large"},
+		{fees.TxClassMaximum, 8_001, 16_384, "maximum"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.wantName, func(t *testing.T) {
+			if got := tc.class.String(); got != tc.wantName {
+				t.Errorf("String() = %q, want %q", got, tc.wantName)
+			}
+			lo, hi, err := fees.EstimateFeeBySizeClass(p, tc.class)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			wantLo, _ := fees.MinFee(p, tc.loSize)
+			wantHi, _ := fees.MinFee(p, tc.hiSize)
+			if lo != wantLo || hi != wantHi {
+				t.Errorf("got (%d, %d), want (%d, %d)", lo, hi, wantLo, wantHi)
+			}
+		})
+	}
+
+	small := p
+	small.MaxTxSize = 4_000
+	if _, _, err := fees.EstimateFeeBySizeClass(small, fees.TxClassMaximum); err == nil {
+		t.Error("expected error when class starts above MaxTxSize")
+	}
+	if _, hi, err := fees.EstimateFeeBySizeClass(small, fees.TxClassLarge); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	} else if want, _ := fees.MinFee(small, 4_000); hi != want {
+		t.Errorf("large class should clamp to MaxTxSize: got %d, want %d", hi, want)
+	}
+	if _, _, err := fees.EstimateFeeBySizeClass(p, fees.TxSizeClass(42)); err == nil {
+		t.Error("expected error for unknown class")
+	}
+	if _, _, err := fees.EstimateFeeBySizeClass(fees.ProtocolParams{}, fees.TxClassSmall); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
+
+func TestTxSizeWithReferenceScripts(t *testing.T) {
+	tests := []struct {
+		name       string
+		refInputs  uint64
+		hasMeta    bool
+		wantSize   uint64
+		scriptSize uint64
+	}{
+		{"no reference inputs", 0, false, 610, 0},
+		{"one reference input", 1, false, 653, 4_000},
+		{"two reference inputs with metadata", 2, true, 610 + 250 + 83, 8_000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ref := fees.TxSizeWithReferenceScripts(2, 2, tc.refInputs, tc.hasMeta)
+			if ref != tc.wantSize {
+				t.Errorf("reference size = %d, want %d", ref, tc.wantSize)
+			}
+			if tc.scriptSize == 0 {
+				return
+			}
+			inline := fees.TxSizeWithInlineScripts(2, 2, tc.scriptSize, tc.hasMeta)
+			if ref >= inline {
+				t.Errorf("reference size %d should be smaller than inline size %d", ref, inline)
+			}
+		})
+	}
+
+	if got := fees.TxSizeWithInlineScripts(2, 2, 0, false); got != 610 {
+		t.Errorf("inline size without scripts = %d, want 610", got)
+	}
+}
+
+func TestMinFeeUpperBoundWithWitnesses(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name      string
+		inputs    uint64
+		witnesses uint64
+		wantFee   uint64
+		wantErr   bool
+	}{
+		{"single signer matches structural estimate", 1, 1, 44*(200+140+65) + 155381, false},
+		{"2 inputs, 3 signers", 2, 3, 44*(200+2*(40+300)+65) + 155381, false},
+		{"0 inputs", 0, 1, 0, true},
+		{"0 witnesses", 1, 0, 0, true},
+		{"overflow", math.MaxUint64, 2, 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MinFeeUpperBoundWithWitnesses(p, tc.inputs, tc.witnesses)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.wantFee {
+				t.Errorf("fee = %d, want %d", got, tc.wantFee)
+			}
+		})
+	}
+}
+
+func TestMinFeeUpperBoundForScriptTx(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	units := fees.ExUnits{Memory: 500_000, Steps: 200_000_000}
+	prices := fees.DefaultMainnetExecutionPrices()
+
+	got, err := fees.MinFeeUpperBoundForScriptTx(p, 2, 2, units, prices)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// ceil(577*500000/10000 + 721*200000000/10000000) = 28850 + 14420
+	want := uint64(44*(200+2*140+2*65+250+34)+155381) + 28_850 + 14_420
+	if got != want {
+		t.Errorf("fee = %d, want %d", got, want)
+	}
+
+	expected, _ := fees.EstimateFee(p, 2, 2, false)
+	if got <= expected {
+		t.Errorf("upper bound %d should exceed plain estimate %d", got, expected)
+	}
+
+	// Other networks' prices are used as given.
+	doubled := prices
+	doubled.PriceMemory.Numerator *= 2
+	doubled.PriceSteps.Numerator *= 2
+	dear, err := fees.MinFeeUpperBoundForScriptTx(p, 2, 2, units, doubled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := want + 28_850 + 14_420; dear != want {
+		t.Errorf("fee at doubled prices = %d, want %d", dear, want)
+	}
+
+	if _, err := fees.MinFeeUpperBoundForScriptTx(p, 2, 2, fees.ExUnits{}, prices); err == nil {
+		t.Error("expected error for zero ExUnits")
+	}
+	if _, err := fees.MinFeeUpperBoundForScriptTx(p, 2, 2, units, fees.ExecutionPrices{}); err == nil {
+		t.Error("expected error for zero prices")
+	}
+}
+
+func TestFeeEstimateOptionsBuilders(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	base := fees.FeeEstimateOptions{NumInputs: 2, NumOutputs: 2}
+	units := fees.ExUnits{Memory: 500_000, Steps: 200_000_000}
+	prices := fees.DefaultMainnetExecutionPrices()
+
+	plutus := base.WithPlutusBudget(units, prices)
+	if !plutus.HasPlutusScripts || plutus.ExUnits != units || plutus.ExecutionPrices != prices {
+		t.Errorf("WithPlutusBudget = %+v", plutus)
+	}
+	if base.HasPlutusScripts {
+		t.Error("WithPlutusBudget modified the receiver")
+	}
+
+	tests := []struct {
+		name      string
+		opts      fees.FeeEstimateOptions
+		wantSize  uint64
+		wantExtra uint64 // fees that do not depend on size
+		wantErr   bool
+	}{
+		{"base", base, 200 + 2*140 + 2*65, 0, false},
+		{"ref scripts", base.WithRefScriptBytes(4_000), 200 + 2*140 + 2*65 + 43, 4_000 * 15, false},
+		{"bootstrap witness", base.WithBootstrapWitnesses(1), 200 + 2*140 + 2*65 + 40, 0, false},
+		{"too many bootstrap witnesses", base.WithBootstrapWitnesses(3), 0, 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.EstimateFeeWithOptions(p, tc.opts)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if want := 44*tc.wantSize + 155381 + tc.wantExtra; got != want {
+				t.Errorf("fee = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestEstimateFeeWithOptionsMatchesRefScriptTransactionFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	units := fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000}
+	prices := fees.DefaultMainnetExecutionPrices()
+
+	for _, refBytes := range []uint64{1, 10_000, 25_600, 60_000} {
+		opts := fees.FeeEstimateOptions{NumInputs: 2, NumOutputs: 2}.
+			WithPlutusBudget(units, prices).
+			WithRefScriptBytes(refBytes)
+		got, err := fees.EstimateFeeWithOptions(p, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		size := 5 + fees.EstimateTxBodyOnlyBytes(opts) + fees.EstimateTxWitnessOnlyBytes(opts) + fees.EstimateTxAuxDataOnlyBytes(opts)
+		b, err := fees.RefScriptTransactionFee(p, size, units, prices, refBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != b.Total() {
+			t.Errorf("%d ref script bytes: EstimateFeeWithOptions = %d, RefScriptTransactionFee = %d (%+v)", refBytes, got, b.Total(), b)
+		}
+	}
+
+	// The fee scales with the script size, not just its presence.
+	small, err := fees.EstimateFeeWithOptions(p, fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1}.WithRefScriptBytes(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	large, err := fees.EstimateFeeWithOptions(p, fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1}.WithRefScriptBytes(50_000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if large <= small {
+		t.Errorf("50,000 ref script bytes cost %d, not more than 10 bytes (%d)", large, small)
+	}
+
+	// The surcharge needs its protocol parameter.
+	p.MinFeeRefScriptCostPerByte = 0
+	if _, err := fees.EstimateFeeWithOptions(p, fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1}.WithRefScriptBytes(100)); err == nil {
+		t.Error("expected error when MinFeeRefScriptCostPerByte is zero")
+	}
+}
+
+func TestFeeForVKeyWitnesses(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	if got := fees.TxVKeyWitnessByteEstimate(); got != 100 {
+		t.Errorf("TxVKeyWitnessByteEstimate() = %d, want 100", got)
+	}
+
+	one, err := fees.FeeForOneVKeyWitness(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if one != 4_400 {
+		t.Errorf("FeeForOneVKeyWitness = %d, want 4400", one)
+	}
+
+	tests := []struct {
+		n       uint64
+		want    uint64
+		wantErr bool
+	}{
+		{0, 0, false},
+		{1, one, false},
+		{3, 13_200, false},
+		{math.MaxUint64, 0, true},
+	}
+
+	for _, tc := range tests {
+		got, err := fees.FeeForVKeyWitnesses(p, tc.n)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("FeeForVKeyWitnesses(%d) err = %v, wantErr %v", tc.n, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Errorf("FeeForVKeyWitnesses(%d) = %d, want %d", tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestMarginalFeeForNInputsOutputs(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	if got := fees.BytesForNInputs(3); got != 420 {
+		t.Errorf("BytesForNInputs(3) = %d, want 420", got)
+	}
+	if got := fees.BytesForNOutputsADAOnly(2); got != 130 {
+		t.Errorf("BytesForNOutputsADAOnly(2) = %d, want 130", got)
+	}
+
+	in, err := fees.MarginalFeeForNInputs(p, 3)
+	if err != nil || in != 18_480 {
+		t.Errorf("MarginalFeeForNInputs(3) = %d, %v; want 18480", in, err)
+	}
+	one, _ := fees.MarginalFeeForInput(p)
+	if got, _ := fees.MarginalFeeForNInputs(p, 1); got != one {
+		t.Errorf("MarginalFeeForNInputs(1) = %d, want MarginalFeeForInput %d", got, one)
+	}
+	out, err := fees.MarginalFeeForNOutputs(p, 2)
+	if err != nil || out != 5_720 {
+		t.Errorf("MarginalFeeForNOutputs(2) = %d, %v; want 5720", out, err)
+	}
+
+	// The marginal fees account for the whole structural estimate beyond
+	// the base size.
+	base, _ := fees.MinFee(p, 200)
+	full, _ := fees.EstimateFee(p, 3, 2, false)
+	if base+in+out != full {
+		t.Errorf("base %d + inputs %d + outputs %d != EstimateFee %d", base, in, out, full)
+	}
+
+	if _, err := fees.MarginalFeeForNInputs(p, math.MaxUint64/100); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func TestRecalculateFeeAfterChangingOutput(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	prev, _ := fees.MinFee(p, 450)
+	got, err := fees.RecalculateFeeAfterChangingOutput(p, prev, 450, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want, _ := fees.MinFee(p, 454); got != want {
+		t.Errorf("fee = %d, want %d", got, want)
+	}
+	if got-prev != 4*44 {
+		t.Errorf("fee grew by %d, want %d", got-prev, 4*44)
+	}
+
+	if _, err := fees.RecalculateFeeAfterChangingOutput(p, prev, math.MaxUint64, 1); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func TestConvergeFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	fee, iterations, err := fees.ConvergeFee(p, 1, 1, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A ~173k Lovelace fee encodes as a 5-byte CBOR uint.
+	if want := uint64(44*(200+140+65+5) + 155381); fee != want {
+		t.Errorf("fee = %d, want %d", fee, want)
+	}
+	if iterations < 2 {
+		t.Errorf("iterations = %d, want at least 2", iterations)
+	}
+
+	nft := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 9}
+	withNFT, _, err := fees.ConvergeFee(p, 1, 2, []fees.OutputSize{nft})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := uint64(44*(200+140+65+fees.EstimateOutputBytes(nft)+5) + 155381); withNFT != want {
+		t.Errorf("fee with NFT output = %d, want %d", withNFT, want)
+	}
+
+	if _, _, err := fees.ConvergeFee(p, 1, 1, []fees.OutputSize{nft, nft}); err == nil {
+		t.Error("expected error when more outputs are described than numOutputs")
+	}
+	if _, _, err := fees.ConvergeFee(p, 0, 1, nil); err == nil {
+		t.Error("expected error for zero inputs")
+	}
+
+	huge := fees.OutputSize{AddressBytes: math.MaxUint64 / 2}
+	_, _, err = fees.ConvergeFee(p, 1, 2, []fees.OutputSize{huge, huge})
+	var feeErr *fees.FeeError
+	if !errors.As(err, &feeErr) {
+		t.Errorf("output sizes overflowing uint64: err = %v, want *FeeError", err)
+	}
+}
+
+func TestEstimateTxBodyAndWitnessBytes(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name        string
+		opts        fees.FeeEstimateOptions
+		wantBody    uint64
+		wantWitness uint64
+		wantAux     uint64
+	}{
+		{"1in 2out", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2}, 195 + 40 + 130, 100, 0},
+		// The body carries the 35-byte hash field of the metadata estimate.
+		{"metadata", fees.FeeEstimateOptions{NumInputs: 2, NumOutputs: 1, HasMetadata: true}, 195 + 80 + 65 + 35, 200, 215},
+		{"explicit metadata", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, HasMetadata: true, MetadataBytes: 600}, 195 + 40 + 65 + 35, 100, 565},
+		{"metadata below hash size", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, HasMetadata: true, MetadataBytes: 20}, 195 + 40 + 65 + 20, 100, 0},
+		{"bootstrap", fees.FeeEstimateOptions{NumInputs: 2, NumOutputs: 1, BootstrapWitnesses: 1}, 195 + 80 + 65, 240, 0},
+		{"network id", fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, IncludeNetworkID: true}, 195 + 40 + 65 + 2, 100, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body := fees.EstimateTxBodyOnlyBytes(tc.opts)
+			witness := fees.EstimateTxWitnessOnlyBytes(tc.opts)
+			aux := fees.EstimateTxAuxDataOnlyBytes(tc.opts)
+			if body != tc.wantBody || witness != tc.wantWitness || aux != tc.wantAux {
+				t.Errorf("body, witness, aux = %d, %d, %d; want %d, %d, %d", body, witness, aux, tc.wantBody, tc.wantWitness, tc.wantAux)
+			}
+
+			// 5-byte outer array + body + witness set + auxiliary data is
+			// the size that EstimateFeeWithOptions charges for.
+			total, err := fees.EstimateFeeWithOptions(p, tc.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want, _ := fees.MinFee(p, 5+body+witness+aux); total != want {
+				t.Errorf("EstimateFeeWithOptions = %d, want MinFee(5+body+witness+aux) = %d", total, want)
+			}
+		})
+	}
+
+	// The split must agree with the whole-transaction byte model.
+	opts := fees.FeeEstimateOptions{NumInputs: 2, NumOutputs: 2, HasMetadata: true}
+	split := 5 + fees.EstimateTxBodyOnlyBytes(opts) + fees.EstimateTxWitnessOnlyBytes(opts) + fees.EstimateTxAuxDataOnlyBytes(opts)
+	if whole := fees.TxSizeWithReferenceScripts(2, 2, 0, true); split != whole {
+		t.Errorf("split size = %d, TxSizeWithReferenceScripts = %d", split, whole)
+	}
+	if fee, _ := fees.EstimateFee(p, 2, 2, true); fee != 193_221 {
+		t.Errorf("EstimateFee(p, 2, 2, true) = %d, want 193221", fee)
+	}
+}
+
+func TestEstimateFeeWithUncertainty(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	opts := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2} // 470 bytes
+
+	u, err := fees.EstimateFeeWithUncertainty(p, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	est, _ := fees.EstimateFeeWithOptions(p, opts)
+	lower, _ := fees.MinFee(p, 470-47)
+	upper, _ := fees.MinFee(p, 470+47)
+	if u.EstimatedFee != est || u.LowerBound != lower || u.UpperBound != upper {
+		t.Errorf("got %+v, want estimate %d, bounds %d–%d", u, est, lower, upper)
+	}
+	if !(u.LowerBound < u.EstimatedFee && u.EstimatedFee < u.UpperBound) {
+		t.Errorf("bounds %d–%d do not bracket %d", u.LowerBound, u.UpperBound, u.EstimatedFee)
+	}
+	if u.RecommendedFee() != u.UpperBound {
+		t.Errorf("RecommendedFee() = %d, want UpperBound %d", u.RecommendedFee(), u.UpperBound)
+	}
+	if want := float64(upper-est) / float64(est) * 100; math.Abs(u.PercentUncertainty-want) > 1e-9 {
+		t.Errorf("PercentUncertainty = %v, want %v", u.PercentUncertainty, want)
+	}
+
+	// The upper bound never exceeds the fee for a MaxTxSize transaction.
+	big := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1, HasMetadata: true, MetadataBytes: 15_500}
+	u, err = fees.EstimateFeeWithUncertainty(p, big)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if maxFee, _ := fees.MinFee(p, p.MaxTxSize); u.UpperBound != maxFee {
+		t.Errorf("UpperBound = %d, want capped at %d", u.UpperBound, maxFee)
+	}
+
+	if _, err := fees.EstimateFeeWithUncertainty(p, fees.FeeEstimateOptions{NumOutputs: 1}); err == nil {
+		t.Error("expected error for zero inputs")
+	}
+}
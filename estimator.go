@@ -0,0 +1,78 @@
+package fees
+
+import "fmt"
+
+// WitnessType identifies the kind of a transaction witness, which
+// determines how many bytes it adds to the witness set.
+type WitnessType uint8
+
+const (
+	// WitnessVKey is a Shelley VKey witness: a 32-byte verification key
+	// and a 64-byte signature. ~100 bytes.
+	WitnessVKey WitnessType = iota
+
+	// WitnessBootstrap is a Byron bootstrap witness, which also carries a
+	// chain code and address attributes. ~140 bytes.
+	WitnessBootstrap
+)
+
+// String returns the witness type's name, e.g. "vkey".
+func (t WitnessType) String() string {
+	switch t {
+	case WitnessVKey:
+		return "vkey"
+	case WitnessBootstrap:
+		return "bootstrap"
+	default:
+		return fmt.Sprintf("WitnessType(%d)", uint8(t))
+	}
+}
+
+// TxSizeEstimator is an immutable running total of a transaction's
+// estimated size in bytes, for code that builds a transaction step by step.
+// Each Add method returns a new estimator and leaves the receiver
+// unchanged, so intermediate states can be kept and compared.
+//
+// Example:
+//
+//	e := fees.NewTxSizeEstimator().
+//		AddInput().AddWitness(fees.WitnessVKey).
+//		AddOutput(fees.OutputSize{AddressBytes: 57})
+//	fee, err := e.Fee(fees.DefaultMainnetParams())
+type TxSizeEstimator uint64
+
+// NewTxSizeEstimator returns an estimator holding the ~200-byte base
+// transaction overhead of the structural byte model.
+func NewTxSizeEstimator() TxSizeEstimator {
+	return TxSizeEstimator(baseTxSize)
+}
+
+// AddInput returns e plus one ~40-byte TxIn reference. The input's witness
+// is not included; add it with AddWitness.
+func (e TxSizeEstimator) AddInput() TxSizeEstimator {
+	return e + TxSizeEstimator(txInBytes)
+}
+
+// AddOutput returns e plus EstimateOutputBytes(out).
+func (e TxSizeEstimator) AddOutput(out OutputSize) TxSizeEstimator {
+	return e + TxSizeEstimator(EstimateOutputBytes(out))
+}
+
+// AddWitness returns e plus one witness of type t. Unknown types are sized
+// as VKey witnesses.
+func (e TxSizeEstimator) AddWitness(t WitnessType) TxSizeEstimator {
+	if t == WitnessBootstrap {
+		return e + TxSizeEstimator(bootstrapWitnessBytes)
+	}
+	return e + TxSizeEstimator(vkeyWitnessBytes)
+}
+
+// Bytes returns the accumulated size in bytes.
+func (e TxSizeEstimator) Bytes() uint64 {
+	return uint64(e)
+}
+
+// Fee returns the minimum fee for a transaction of e.Bytes() bytes.
+func (e TxSizeEstimator) Fee(p ProtocolParams) (uint64, error) {
+	return MinFee(p, e.Bytes())
+}
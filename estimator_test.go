@@ -0,0 +1,43 @@
+package fees_test
+
+import (
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestTxSizeEstimator(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+
+	base := fees.NewTxSizeEstimator()
+	if base.Bytes() != 200 {
+		t.Errorf("NewTxSizeEstimator().Bytes() = %d, want 200", base.Bytes())
+	}
+
+	withInput := base.AddInput().AddWitness(fees.WitnessVKey)
+	if withInput.Bytes() != 200+fees.TxInputByteEstimate() {
+		t.Errorf("input with witness = %d bytes, want %d", withInput.Bytes(), 200+fees.TxInputByteEstimate())
+	}
+	if base.Bytes() != 200 {
+		t.Error("AddInput modified the receiver")
+	}
+
+	full := withInput.AddOutput(out)
+	if want := 200 + 140 + fees.EstimateOutputBytes(out); full.Bytes() != want {
+		t.Errorf("Bytes() = %d, want %d", full.Bytes(), want)
+	}
+
+	byron := base.AddInput().AddWitness(fees.WitnessBootstrap)
+	if byron.Bytes()-withInput.Bytes() != 40 {
+		t.Errorf("bootstrap witness should be 40 bytes larger than a VKey witness")
+	}
+
+	fee, err := full.Fee(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinFee(p, full.Bytes()); fee != want {
+		t.Errorf("Fee = %d, want %d", fee, want)
+	}
+}
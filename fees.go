@@ -1,6 +1,12 @@
 package fees
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"math/bits"
+)
 
 // MinFee calculates the minimum transaction fee in Lovelace using the
 // Cardano linear fee formula:
@@ -12,7 +18,9 @@ import "fmt"
 // is to build the transaction with a dummy fee, measure its byte length,
 // then recalculate.
 //
-// Returns an error if params are invalid or txSizeBytes exceeds MaxTxSize.
+// Returns a *ParamError if params are invalid, or a *TxFeeEstimationError
+// wrapping ErrTxTooSmall or ErrTxTooLarge if txSizeBytes is zero or
+// exceeds MaxTxSize.
 //
 // Example:
 //
@@ -23,15 +31,128 @@ func MinFee(p ProtocolParams, txSizeBytes uint64) (uint64, error) {
 	if err := p.Validate(); err != nil {
 		return 0, err
 	}
+	return minFee(p, txSizeBytes)
+}
+
+// minFee is MinFee without parameter validation, for callers that have
+// already validated p. Every estimator goes through it, so it checks for
+// overflow with math/bits rather than allocating like minFeeBig.
+func minFee(p ProtocolParams, txSizeBytes uint64) (uint64, error) {
+	if err := checkTxSize(p, txSizeBytes); err != nil {
+		return 0, err
+	}
+	hi, product := bits.Mul64(p.MinFeeA, txSizeBytes)
+	fee, carry := bits.Add64(product, p.MinFeeB, 0)
+	if hi != 0 || carry != 0 {
+		return 0, NewFeeError(fmt.Sprintf("fee for %d bytes overflows uint64", txSizeBytes))
+	}
+	return fee, nil
+}
+
+// MinFeeAsBigInt calculates the minimum fee like MinFee, but using math/big
+// arithmetic so that the intermediate product MinFeeA * txSizeBytes cannot
+// silently wrap around. It always agrees with MinFee, which performs the
+// same overflow check without allocating.
+//
+// Returns an error if params are invalid, txSizeBytes is out of range, or
+// the fee does not fit in a uint64 Lovelace amount.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeAsBigInt(p, 350)
+//	// fee.Uint64() = 170,781
+func MinFeeAsBigInt(p ProtocolParams, txSizeBytes uint64) (*big.Int, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return minFeeBig(p, txSizeBytes)
+}
+
+// minFeeBig is MinFeeAsBigInt without parameter validation.
+func minFeeBig(p ProtocolParams, txSizeBytes uint64) (*big.Int, error) {
+	if err := checkTxSize(p, txSizeBytes); err != nil {
+		return nil, err
+	}
+	fee := new(big.Int).SetUint64(p.MinFeeA)
+	fee.Mul(fee, new(big.Int).SetUint64(txSizeBytes))
+	fee.Add(fee, new(big.Int).SetUint64(p.MinFeeB))
+	if !fee.IsUint64() {
+		return nil, NewFeeError(fmt.Sprintf("fee for %d bytes overflows uint64", txSizeBytes))
+	}
+	return fee, nil
+}
+
+// checkTxSize returns a *TxFeeEstimationError if txSizeBytes is zero or
+// exceeds p.MaxTxSize.
+func checkTxSize(p ProtocolParams, txSizeBytes uint64) error {
 	if txSizeBytes == 0 {
-		return 0, &FeeError{Reason: "txSizeBytes must be greater than zero"}
+		return &TxFeeEstimationError{Cause: ErrTxTooSmall, Reason: "txSizeBytes must be greater than zero"}
 	}
 	if txSizeBytes > p.MaxTxSize {
-		return 0, &FeeError{
-			Reason: fmt.Sprintf("txSizeBytes %d exceeds MaxTxSize %d", txSizeBytes, p.MaxTxSize),
-		}
+		return &TxFeeEstimationError{Cause: ErrTxTooLarge, Reason: fmt.Sprintf("txSizeBytes %d exceeds MaxTxSize %d", txSizeBytes, p.MaxTxSize)}
 	}
-	return p.MinFeeA*txSizeBytes + p.MinFeeB, nil
+	return nil
+}
+
+// SafeMinFee calculates the minimum fee like MinFee but never returns an
+// error, for display code such as UI previews and explorers. The bool
+// reports whether txSizeBytes is within MaxTxSize.
+//
+// If the transaction is too large, the fee for a MaxTxSize transaction is
+// returned with false, so the caller can show "this transaction is too
+// large; it would cost at least X". If p is invalid, txSizeBytes is zero,
+// or the fee overflows uint64, it returns 0, false, matching the cases in
+// which MinFee returns an error.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, ok := fees.SafeMinFee(p, 20_000)
+//	// ok = false, fee = MinFee(p, 16384)
+func SafeMinFee(p ProtocolParams, txSizeBytes uint64) (uint64, bool) {
+	if err := p.Validate(); err != nil {
+		return 0, false
+	}
+	ok := true
+	if txSizeBytes > p.MaxTxSize {
+		txSizeBytes, ok = p.MaxTxSize, false
+	}
+	fee, err := minFee(p, txSizeBytes)
+	if err != nil {
+		return 0, false
+	}
+	return fee, ok
+}
+
+// TxSizeFromCBOR returns the size in bytes of a serialized transaction,
+// for use with MinFee. The bytes are not parsed or checked for validity.
+//
+// Returns an error if cborBytes is empty.
+//
+// Example:
+//
+//	size, err := fees.TxSizeFromCBOR(signedTxCBOR)
+func TxSizeFromCBOR(cborBytes []byte) (uint64, error) {
+	if len(cborBytes) == 0 {
+		return 0, NewFeeError("cborBytes must not be empty")
+	}
+	return uint64(len(cborBytes)), nil
+}
+
+// MinFeeFromCBOR calculates the minimum fee for a serialized transaction.
+// It is MinFee(p, TxSizeFromCBOR(cborBytes)).
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeFromCBOR(p, signedTxCBOR)
+func MinFeeFromCBOR(p ProtocolParams, cborBytes []byte) (uint64, error) {
+	size, err := TxSizeFromCBOR(cborBytes)
+	if err != nil {
+		return 0, err
+	}
+	return MinFee(p, size)
 }
 
 // MinFeeWithPadding calculates the minimum transaction fee and adds a safety
@@ -56,6 +177,9 @@ func MinFeeWithPadding(p ProtocolParams, txSizeBytes, paddingBytes uint64) (uint
 // This is an approximation useful for UI display and pre-flight checks.
 // For exact fees, serialize the full transaction and use MinFee.
 //
+// Returns a *ParamError if p is invalid and a *FeeError if numInputs or
+// numOutputs is zero.
+//
 // Example:
 //
 //	p := fees.DefaultMainnetParams()
@@ -65,30 +189,133 @@ func EstimateFee(p ProtocolParams, numInputs, numOutputs uint64, hasMetadata boo
 		return 0, err
 	}
 	if numInputs == 0 {
-		return 0, &FeeError{Reason: "numInputs must be at least 1"}
+		return 0, NewFeeError("numInputs must be at least 1")
 	}
 	if numOutputs == 0 {
-		return 0, &FeeError{Reason: "numOutputs must be at least 1"}
+		return 0, NewFeeError("numOutputs must be at least 1")
+	}
+	return estimateFeeWithOptions(p, FeeEstimateOptions{
+		NumInputs:   numInputs,
+		NumOutputs:  numOutputs,
+		HasMetadata: hasMetadata,
+	})
+}
+
+// MinFeeForSingleInputSingleOutput returns the estimated fee for the
+// simplest possible transaction: one key-witnessed input, one output, and
+// no metadata. It is EstimateFee(p, 1, 1, false), assuming
+// 200 + 140 + 65 = 405 bytes.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeForSingleInputSingleOutput(p)
+//	// fee = 44*405 + 155381 = 173,201
+func MinFeeForSingleInputSingleOutput(p ProtocolParams) (uint64, error) {
+	return EstimateFee(p, 1, 1, false)
+}
+
+// MinFeeForTwoInTwoOut returns the estimated fee for a two-input,
+// two-output transaction without metadata, the typical shape of a payment
+// with change. It is EstimateFee(p, 2, 2, false), assuming
+// 200 + 2*140 + 2*65 = 610 bytes.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeForTwoInTwoOut(p)
+//	// fee = 44*610 + 155381 = 182,221
+func MinFeeForTwoInTwoOut(p ProtocolParams) (uint64, error) {
+	return EstimateFee(p, 2, 2, false)
+}
+
+// FeeAccuracyReport compares an estimated fee with the fee actually charged
+// on-chain. See CompareFeeAccuracy.
+type FeeAccuracyReport struct {
+	// Estimated is the fee the caller estimated before submission.
+	Estimated uint64
+
+	// Actual is the fee recorded in the confirmed transaction.
+	Actual uint64
+
+	// DeltaLovelace is Estimated - Actual, saturated to ±math.MaxInt64.
+	// Positive values mean the estimate was too high; negative values mean
+	// it was too low.
+	DeltaLovelace int64
+
+	// DeltaBPS is Estimated - Actual expressed in basis points of Actual
+	// (1 bps = 0.01%), truncated toward zero and saturated to
+	// ±math.MaxInt64. When Actual is zero and Estimated is not, the
+	// deviation is unbounded and DeltaBPS is math.MaxInt64.
+	DeltaBPS int64
+}
+
+// CompareFeeAccuracy builds a FeeAccuracyReport for a confirmed transaction,
+// for use in tuning fee estimation after submission.
+//
+// Example:
+//
+//	r := fees.CompareFeeAccuracy(180_000, 170_781)
+//	// r.DeltaLovelace = 9219, r.DeltaBPS = 539 (≈ 5.4% over)
+func CompareFeeAccuracy(estimatedFee, actualFee uint64) FeeAccuracyReport {
+	over := estimatedFee >= actualFee
+	var delta uint64
+	if over {
+		delta = estimatedFee - actualFee
+	} else {
+		delta = actualFee - estimatedFee
 	}
 
-	// Empirically-derived byte model:
-	//   base tx overhead:  ~200 bytes
-	//   per input:         ~40 bytes (TxIn hash+index + Vkey witness ~100 bytes)
-	//   per output:        ~65 bytes (address + value)
-	//   metadata overhead: ~250 bytes estimate
-	const (
-		baseTxSize    uint64 = 200
-		bytesPerInput uint64 = 140 // includes witness
-		bytesPerOutput uint64 = 65
-		metadataSize  uint64 = 250
-	)
+	r := FeeAccuracyReport{
+		Estimated:     estimatedFee,
+		Actual:        actualFee,
+		DeltaLovelace: saturatedInt64(delta, over),
+	}
+	switch {
+	case delta == 0:
+	case actualFee == 0:
+		r.DeltaBPS = math.MaxInt64
+	default:
+		// delta*10000/actualFee in 128 bits; Div64 requires hi < actualFee,
+		// and anything larger saturates anyway.
+		bps := uint64(math.MaxUint64)
+		if hi, lo := bits.Mul64(delta, 10_000); hi < actualFee {
+			bps, _ = bits.Div64(hi, lo, actualFee)
+		}
+		r.DeltaBPS = saturatedInt64(bps, over)
+	}
+	return r
+}
 
-	estimated := baseTxSize + bytesPerInput*numInputs + bytesPerOutput*numOutputs
-	if hasMetadata {
-		estimated += metadataSize
+// saturatedInt64 returns mag as an int64, negated unless positive, clamped
+// to ±math.MaxInt64.
+func saturatedInt64(mag uint64, positive bool) int64 {
+	if mag > math.MaxInt64 {
+		mag = math.MaxInt64
+	}
+	if positive {
+		return int64(mag)
 	}
+	return -int64(mag)
+}
 
-	return MinFee(p, estimated)
+// IsAcceptableAccuracy reports whether the absolute deviation in r is at
+// most maxDeltaBPS basis points, in either direction. A report with a zero
+// Actual and a non-zero Estimated is never acceptable.
+//
+// Example:
+//
+//	r := fees.CompareFeeAccuracy(180_000, 170_781)
+//	ok := fees.IsAcceptableAccuracy(r, 1_000) // true: within 10%
+func IsAcceptableAccuracy(r FeeAccuracyReport, maxDeltaBPS uint64) bool {
+	if r.Actual == 0 && r.Estimated != 0 {
+		return false
+	}
+	d := r.DeltaBPS
+	if d < 0 {
+		d = -d
+	}
+	return uint64(d) <= maxDeltaBPS
 }
 
 // FeeError is returned when a fee calculation cannot be completed.
@@ -97,6 +324,54 @@ type FeeError struct {
 	Reason string
 }
 
+// NewFeeError returns a *FeeError with the given reason. It panics if
+// reason is empty, so that every FeeError explains itself.
+//
+// Example:
+//
+//	return 0, fees.NewFeeError("numInputs must be at least 1")
+func NewFeeError(reason string) *FeeError {
+	if reason == "" {
+		panic("fees: NewFeeError: reason must not be empty")
+	}
+	return &FeeError{Reason: reason}
+}
+
 func (e *FeeError) Error() string {
 	return "fees: " + e.Reason
-}
\ No newline at end of file
+}
+
+// Sentinel causes carried by TxFeeEstimationError.Cause, for matching with
+// errors.Is:
+//
+//	if errors.Is(err, fees.ErrTxTooLarge) { ... }
+var (
+	ErrTxTooLarge = errors.New("fees: transaction exceeds MaxTxSize")
+	ErrTxTooSmall = errors.New("fees: transaction size is zero")
+)
+
+// TxFeeEstimationError is returned when a transaction size cannot be
+// priced under otherwise valid parameters, as opposed to a *ParamError for
+// the parameters themselves. Use errors.As to tell the two apart:
+//
+//	var te *fees.TxFeeEstimationError
+//	if errors.As(err, &te) && errors.Is(te, fees.ErrTxTooLarge) {
+//		// split the transaction
+//	}
+type TxFeeEstimationError struct {
+	// Reason describes why the estimate failed.
+	Reason string
+
+	// Cause is ErrTxTooLarge or ErrTxTooSmall. It is returned by Unwrap.
+	Cause error
+}
+
+func (e *TxFeeEstimationError) Error() string {
+	return "fees: " + e.Reason
+}
+
+// Unwrap returns e.Cause, so that errors.Is(err, fees.ErrTxTooLarge)
+// matches.
+func (e *TxFeeEstimationError) Unwrap() error {
+	return e.Cause
+}
@@ -2,6 +2,10 @@ package fees_test
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"testing"
 
 	fees "github.com/njchilds90/go-cardano-fees"
@@ -14,7 +18,7 @@ func TestMinFee(t *testing.T) {
 		name        string
 		txSize      uint64
 		wantFee     uint64
-		wantErrType interface{}
+		wantErrType error
 	}{
 		{
 			name:    "typical simple tx 300 bytes",
@@ -34,12 +38,12 @@ func TestMinFee(t *testing.T) {
 		{
 			name:        "zero bytes",
 			txSize:      0,
-			wantErrType: &fees.FeeError{},
+			wantErrType: fees.ErrTxTooSmall,
 		},
 		{
 			name:        "exceeds max tx size",
 			txSize:      20000,
-			wantErrType: &fees.FeeError{},
+			wantErrType: fees.ErrTxTooLarge,
 		},
 	}
 
@@ -47,8 +51,8 @@ func TestMinFee(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			got, err := fees.MinFee(p, tc.txSize)
 			if tc.wantErrType != nil {
-				if err == nil {
-					t.Fatalf("expected error, got nil")
+				if !errors.Is(err, tc.wantErrType) {
+					t.Fatalf("err = %v, want %v", err, tc.wantErrType)
 				}
 				return
 			}
@@ -94,8 +98,9 @@ func TestEstimateFee(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			fee, err := fees.EstimateFee(p, tc.inputs, tc.outputs, tc.hasMeta)
 			if tc.wantErr {
-				if err == nil {
-					t.Fatal("expected error, got nil")
+				var fe *fees.FeeError
+				if !errors.As(err, &fe) {
+					t.Fatalf("err = %v, want *FeeError", err)
 				}
 				return
 			}
@@ -142,4 +147,552 @@ func TestDefaultPreviewParams(t *testing.T) {
 	if err := p.Validate(); err != nil {
 		t.Errorf("DefaultPreviewParams should be valid: %v", err)
 	}
-}
\ No newline at end of file
+}
+
+func TestProtocolParamsFormulas(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	if got, want := p.FeeFormula(), "fee = 44 × txSizeBytes + 155,381 Lovelace"; got != want {
+		t.Errorf("FeeFormula() = %q, want %q", got, want)
+	}
+	if got, want := p.MinUTxOFormula(), "minUTxO = (160 + serializedOutputBytes) × 4,310 Lovelace"; got != want {
+		t.Errorf("MinUTxOFormula() = %q, want %q", got, want)
+	}
+}
+
+func TestProtocolParamsFeeForBytes(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	if got := p.MinFeeForByte(); got != 44 {
+		t.Errorf("MinFeeForByte() = %d, want 44", got)
+	}
+
+	tests := []struct {
+		n    uint64
+		want uint64
+	}{
+		{0, 0},
+		{1, 44},
+		{300, 13_200},
+		{16_384, 720_896},
+	}
+	for _, tc := range tests {
+		if got := p.FeeForBytes(tc.n); got != tc.want {
+			t.Errorf("FeeForBytes(%d) = %d, want %d", tc.n, got, tc.want)
+		}
+		if tc.n == 0 {
+			continue
+		}
+		fee, err := fees.MinFee(p, tc.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := p.FeeForBytes(tc.n) + p.MinFeeB; got != fee {
+			t.Errorf("FeeForBytes(%d) + MinFeeB = %d, want MinFee %d", tc.n, got, fee)
+		}
+	}
+}
+
+func TestDefaultMainnetAccessors(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	tests := []struct {
+		name string
+		got  uint64
+		want uint64
+	}{
+		{"CoinsPerUTxOByte", fees.DefaultMainnetCoinsPerUTxOByte(), p.CoinsPerUTxOByte},
+		{"MinFeeA", fees.DefaultMainnetMinFeeA(), p.MinFeeA},
+		{"MinFeeB", fees.DefaultMainnetMinFeeB(), p.MinFeeB},
+		{"MaxTxSize", fees.DefaultMainnetMaxTxSize(), p.MaxTxSize},
+	}
+	for _, tc := range tests {
+		if tc.got != tc.want {
+			t.Errorf("DefaultMainnet%s() = %d, want %d", tc.name, tc.got, tc.want)
+		}
+	}
+}
+
+func TestProtocolParamsString(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	got := p.String()
+	want := "MinFeeA:          44 Lovelace\n" +
+		"MinFeeB:          155,381 Lovelace\n" +
+		"CoinsPerUTxOByte: 4,310 Lovelace\n" +
+		"MaxTxSize:        16,384 bytes"
+	if got != want {
+		t.Errorf("String() =\n%s\nwant\n%s", got, want)
+	}
+	for _, field := range []string{"MinFeeA", "MinFeeB", "CoinsPerUTxOByte", "MaxTxSize"} {
+		if !strings.Contains(got, field) {
+			t.Errorf("String() missing field %q", field)
+		}
+	}
+}
+
+func TestProtocolParamsFormat(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	// %v is parseable: Key=value pairs that rebuild the same params.
+	values := make(map[string]string)
+	for _, pair := range strings.Fields(fmt.Sprintf("%v", p)) {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			t.Fatalf("%%v pair %q is not Key=value", pair)
+		}
+		values[key] = value
+	}
+	num := func(key string) uint64 {
+		n, err := strconv.ParseUint(values[key], 10, 64)
+		if err != nil {
+			t.Fatalf("%%v field %s: %v", key, err)
+		}
+		return n
+	}
+	parsed := fees.ProtocolParams{
+		MinFeeA:                    num("MinFeeA"),
+		MinFeeB:                    num("MinFeeB"),
+		CoinsPerUTxOByte:           num("CoinsPerUTxOByte"),
+		MaxTxSize:                  num("MaxTxSize"),
+		MaxValueSize:               num("MaxValueSize"),
+		MaxBlockBodySize:           num("MaxBlockBodySize"),
+		MaxBlockHeaderSize:         num("MaxBlockHeaderSize"),
+		CollateralPercentage:       num("CollateralPercentage"),
+		MinFeeRefScriptCostPerByte: num("MinFeeRefScriptCostPerByte"),
+		GovActionDeposit:           num("GovActionDeposit"),
+		KeyDeposit:                 num("KeyDeposit"),
+		PoolDeposit:                num("PoolDeposit"),
+		DRepDeposit:                num("DRepDeposit"),
+		NetworkMagic:               uint32(num("NetworkMagic")),
+		Epoch:                      num("Epoch"),
+	}
+	if values["NetworkID"] == "mainnet" {
+		parsed.NetworkID = fees.NetworkMainnet
+	}
+	if parsed != p {
+		t.Errorf("parsed %%v = %+v, want %+v", parsed, p)
+	}
+
+	detailed := fmt.Sprintf("%+v", p)
+	for _, want := range []string{
+		"ADA",
+		"KeyDeposit:                 2000000 Lovelace (2.000000 ADA)",
+		"PoolDeposit:                500000000 Lovelace (500.000000 ADA)",
+		"MinFeeA:                    44 Lovelace/byte",
+		"NetworkID:                  1 (mainnet)",
+	} {
+		if !strings.Contains(detailed, want) {
+			t.Errorf("%%+v missing %q:\n%s", want, detailed)
+		}
+	}
+
+	if got := fmt.Sprintf("%s", p); got != p.String() {
+		t.Errorf("%%s = %q, want String() %q", got, p.String())
+	}
+	if got := fmt.Sprintf("%d", p); !strings.HasPrefix(got, "%!d(fees.ProtocolParams=MinFeeA=44 ") {
+		t.Errorf("%%d = %q, want bad-verb form", got)
+	}
+}
+
+func TestCompareFeeAccuracy(t *testing.T) {
+	tests := []struct {
+		name      string
+		estimated uint64
+		actual    uint64
+		wantDelta int64
+		wantBPS   int64
+	}{
+		{"exact", 170_781, 170_781, 0, 0},
+		{"over estimate", 180_000, 170_781, 9_219, 539},
+		{"under estimate", 160_000, 170_781, -10_781, -631},
+		{"zero actual", 1_000, 0, 1_000, math.MaxInt64},
+		{"both zero", 0, 0, 0, 0},
+		{"delta beyond int64", math.MaxUint64, 1, math.MaxInt64, math.MaxInt64},
+		{"under beyond int64", 0, math.MaxUint64, -math.MaxInt64, -10_000},
+		{"large over estimate", math.MaxUint64, math.MaxUint64 / 4, math.MaxInt64, 30_000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := fees.CompareFeeAccuracy(tc.estimated, tc.actual)
+			if r.Estimated != tc.estimated || r.Actual != tc.actual {
+				t.Errorf("report fields = %d/%d, want %d/%d", r.Estimated, r.Actual, tc.estimated, tc.actual)
+			}
+			if r.DeltaLovelace != tc.wantDelta {
+				t.Errorf("DeltaLovelace = %d, want %d", r.DeltaLovelace, tc.wantDelta)
+			}
+			if r.DeltaBPS != tc.wantBPS {
+				t.Errorf("DeltaBPS = %d, want %d", r.DeltaBPS, tc.wantBPS)
+			}
+		})
+	}
+}
+
+func TestIsAcceptableAccuracy(t *testing.T) {
+	over := fees.CompareFeeAccuracy(180_000, 170_781)  // +539 bps
+	under := fees.CompareFeeAccuracy(160_000, 170_781) // -631 bps
+
+	if !fees.IsAcceptableAccuracy(over, 1_000) {
+		t.Error("+539 bps should be within 1000 bps")
+	}
+	if fees.IsAcceptableAccuracy(over, 500) {
+		t.Error("+539 bps should not be within 500 bps")
+	}
+	if !fees.IsAcceptableAccuracy(under, 631) {
+		t.Error("-631 bps should be within 631 bps")
+	}
+	if fees.IsAcceptableAccuracy(under, 630) {
+		t.Error("-631 bps should not be within 630 bps")
+	}
+	if fees.IsAcceptableAccuracy(fees.CompareFeeAccuracy(1_000, 0), math.MaxUint64) {
+		t.Error("a non-zero estimate against a zero actual fee should never be acceptable")
+	}
+	if !fees.IsAcceptableAccuracy(fees.CompareFeeAccuracy(0, 0), 0) {
+		t.Error("a zero estimate against a zero actual fee should be exact")
+	}
+}
+
+func TestMinFeeForCommonShapes(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name string
+		fn   func(fees.ProtocolParams) (uint64, error)
+		want uint64
+	}{
+		{"1in 1out", fees.MinFeeForSingleInputSingleOutput, 44*405 + 155381},
+		{"2in 2out", fees.MinFeeForTwoInTwoOut, 44*610 + 155381},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.fn(p)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+			if _, err := tc.fn(fees.ProtocolParams{}); err == nil {
+				t.Error("expected error for zero params")
+			}
+		})
+	}
+}
+
+func TestTxSizeFromCBOR(t *testing.T) {
+	got, err := fees.TxSizeFromCBOR(make([]byte, 350))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 350 {
+		t.Errorf("got %d, want 350", got)
+	}
+
+	var fe *fees.FeeError
+	if _, err := fees.TxSizeFromCBOR(nil); !errors.As(err, &fe) {
+		t.Errorf("expected *FeeError for empty input, got %T", err)
+	}
+}
+
+func TestMinFeeFromCBOR(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name    string
+		size    int
+		wantErr bool
+	}{
+		{"350 bytes", 350, false},
+		{"empty", 0, true},
+		{"exceeds max tx size", 20_000, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MinFeeFromCBOR(p, make([]byte, tc.size))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want, _ := fees.MinFee(p, uint64(tc.size))
+			if got != want {
+				t.Errorf("got %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAllDefaultParams(t *testing.T) {
+	all := fees.AllDefaultParams()
+	for _, name := range []string{"mainnet", "preview", "preprod", "sanchonet"} {
+		if _, ok := all[name]; !ok {
+			t.Errorf("AllDefaultParams missing %q", name)
+		}
+	}
+	for name, p := range all {
+		if err := p.Validate(); err != nil {
+			t.Errorf("%s params should be valid: %v", name, err)
+		}
+	}
+}
+
+func TestSupportedNetworks(t *testing.T) {
+	got := fees.SupportedNetworks()
+	want := []string{"mainnet", "preprod", "preview", "sanchonet"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSafeMinFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	maxFee, _ := fees.MinFee(p, p.MaxTxSize)
+	fee300, _ := fees.MinFee(p, 300)
+	overflowing := p
+	overflowing.MinFeeA = math.MaxUint64 / 2
+
+	tests := []struct {
+		name   string
+		p      fees.ProtocolParams
+		txSize uint64
+		want   uint64
+		wantOK bool
+	}{
+		{"within limit", p, 300, fee300, true},
+		{"at limit", p, p.MaxTxSize, maxFee, true},
+		{"over limit clamps", p, 20_000, maxFee, false},
+		{"invalid params", fees.ProtocolParams{}, 300, 0, false},
+		{"zero size", p, 0, 0, false},
+		{"overflow", overflowing, 300, 0, false},
+		{"overflow over limit", overflowing, 20_000, 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := fees.SafeMinFee(tc.p, tc.txSize)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("got (%d, %v), want (%d, %v)", got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
+
+func TestMinFeeAsBigInt(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	got, err := fees.MinFeeAsBigInt(p, 350)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := fees.MinFee(p, 350)
+	if !got.IsUint64() || got.Uint64() != want {
+		t.Errorf("got %s, want %d", got, want)
+	}
+
+	huge := fees.ProtocolParams{
+		MinFeeA:          math.MaxUint64 / 2,
+		MinFeeB:          1,
+		CoinsPerUTxOByte: 1,
+		MaxTxSize:        16384,
+	}
+	hugeB := huge
+	hugeB.MinFeeB = 2
+	tests := []struct {
+		name   string
+		p      fees.ProtocolParams
+		txSize uint64
+	}{
+		{"zero params", fees.ProtocolParams{}, 350},
+		{"zero size", p, 0},
+		{"exceeds max tx size", p, 20_000},
+		{"overflow", huge, 3},
+		{"overflow in addition", hugeB, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := fees.MinFeeAsBigInt(tc.p, tc.txSize); err == nil {
+				t.Error("expected error, got nil")
+			}
+			if _, err := fees.MinFee(tc.p, tc.txSize); err == nil {
+				t.Error("MinFee: expected error, got nil")
+			}
+		})
+	}
+
+	// MinFeeA*2 + MinFeeB is exactly math.MaxUint64.
+	got, err = fees.MinFeeAsBigInt(huge, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fee, err := fees.MinFee(huge, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee != math.MaxUint64 || got.Uint64() != fee {
+		t.Errorf("at uint64 max: MinFee = %d, MinFeeAsBigInt = %s, want %d", fee, got, uint64(math.MaxUint64))
+	}
+}
+
+func TestParamErrorIs(t *testing.T) {
+	tests := []struct {
+		name   string
+		p      fees.ProtocolParams
+		target error
+	}{
+		{"MinFeeA", fees.ProtocolParams{}, fees.ErrInvalidMinFeeA},
+		{"MinFeeB", fees.ProtocolParams{MinFeeA: 44}, fees.ErrInvalidMinFeeB},
+		{"CoinsPerUTxOByte", fees.ProtocolParams{MinFeeA: 44, MinFeeB: 1}, fees.ErrInvalidCoinsPerUTxOByte},
+		{"MaxTxSize", fees.ProtocolParams{MinFeeA: 44, MinFeeB: 1, CoinsPerUTxOByte: 1}, fees.ErrInvalidMaxTxSize},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.p.Validate()
+			if !errors.Is(err, tc.target) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tc.target)
+			}
+			wrapped := fmt.Errorf("loading params: %w", err)
+			if !errors.Is(wrapped, tc.target) {
+				t.Errorf("wrapped: errors.Is(%v, %v) = false", wrapped, tc.target)
+			}
+			for _, other := range []error{fees.ErrInvalidMinFeeA, fees.ErrInvalidMinFeeB, fees.ErrInvalidCoinsPerUTxOByte, fees.ErrInvalidMaxTxSize} {
+				if other != tc.target && errors.Is(err, other) {
+					t.Errorf("errors.Is(%v, %v) = true, want false", err, other)
+				}
+			}
+		})
+	}
+
+	noPct := fees.DefaultMainnetParams()
+	noPct.CollateralPercentage = 0
+	_, _, err := fees.MinFeeWithCollateralReturn(noPct, 1200, 57, 5_000_000)
+	if !errors.Is(err, fees.ErrInvalidCollateralPercentage) {
+		t.Errorf("errors.Is(%v, ErrInvalidCollateralPercentage) = false", err)
+	}
+	if errors.Is(&fees.FeeError{Reason: "x"}, fees.ErrInvalidMinFeeA) {
+		t.Error("a *FeeError should not match a ParamError sentinel")
+	}
+}
+
+func TestProtocolParamsEpoch(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	if !p.HasEpoch() {
+		t.Error("DefaultMainnetParams should record its snapshot epoch")
+	}
+
+	p.Epoch = 0
+	if p.HasEpoch() {
+		t.Error("HasEpoch should be false for zero Epoch")
+	}
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate should not require Epoch: %v", err)
+	}
+}
+
+func TestCheckProtocolParamsCompatibility(t *testing.T) {
+	mainnet := fees.DefaultMainnetParams()
+
+	partial := fees.ProtocolParams{MinFeeA: 44, MinFeeB: 155381}
+	bumped := mainnet
+	bumped.MinFeeA = 45
+	bumped.MaxTxSize = 32768
+	// NetworkTestnet is zero, but the magic marks the network as known.
+	testnetID := mainnet
+	testnetID.NetworkID = fees.NetworkTestnet
+
+	tests := []struct {
+		name          string
+		a, b          fees.ProtocolParams
+		wantOK        bool
+		wantConflicts []string
+	}{
+		{"identical", mainnet, mainnet, true, nil},
+		{"zero fields are ignored", mainnet, partial, true, nil},
+		{"empty", fees.ProtocolParams{}, mainnet, true, nil},
+		{"conflicts", mainnet, bumped, false, []string{"MinFeeA: 44 != 45", "MaxTxSize: 16384 != 32768"}},
+		{"different networks", mainnet, fees.DefaultPreviewParams(), false, []string{"NetworkID: 1 != 0", "NetworkMagic: 764824073 != 2"}},
+		{"mainnet vs testnet network ID", mainnet, testnetID, false, []string{"NetworkID: 1 != 0"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, conflicts := fees.CheckProtocolParamsCompatibility(tc.a, tc.b)
+			if ok != tc.wantOK {
+				t.Errorf("ok = %v, want %v", ok, tc.wantOK)
+			}
+			if conflicts == nil {
+				t.Fatal("conflicts should be an empty slice, not nil")
+			}
+			if strings.Join(conflicts, "; ") != strings.Join(tc.wantConflicts, "; ") {
+				t.Errorf("conflicts = %q, want %q", conflicts, tc.wantConflicts)
+			}
+		})
+	}
+}
+
+func TestProtocolParamsCopy(t *testing.T) {
+	base := fees.DefaultMainnetParams()
+	cp := base.Copy()
+	if cp != base {
+		t.Fatalf("copy %+v differs from base %+v", cp, base)
+	}
+
+	cp.MinFeeA = 48
+	cp.KeyDeposit = 0
+	if base.MinFeeA != 44 || base.KeyDeposit != 2_000_000 {
+		t.Errorf("mutating the copy changed the base: %+v", base)
+	}
+}
+
+func TestNewErrorConstructors(t *testing.T) {
+	if got := fees.NewFeeError("bad size").Error(); got != "fees: bad size" {
+		t.Errorf("NewFeeError Error() = %q", got)
+	}
+	if got := fees.NewMinUTxOError("bad output").Error(); got != "fees: minUTxO: bad output" {
+		t.Errorf("NewMinUTxOError Error() = %q", got)
+	}
+
+	for name, fn := range map[string]func(){
+		"NewFeeError":     func() { fees.NewFeeError("") },
+		"NewMinUTxOError": func() { fees.NewMinUTxOError("") },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s(\"\") did not panic", name)
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestTxFeeEstimationError(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	_, sizeErr := fees.MinFee(p, p.MaxTxSize+1)
+	var te *fees.TxFeeEstimationError
+	if !errors.As(sizeErr, &te) {
+		t.Fatalf("MinFee(MaxTxSize+1) err = %T, want *TxFeeEstimationError", sizeErr)
+	}
+	if !errors.Is(sizeErr, fees.ErrTxTooLarge) || errors.Is(sizeErr, fees.ErrTxTooSmall) {
+		t.Errorf("err = %v, want only ErrTxTooLarge", sizeErr)
+	}
+	var pe *fees.ParamError
+	if errors.As(sizeErr, &pe) {
+		t.Error("a size error should not be a *ParamError")
+	}
+
+	bad := p
+	bad.MinFeeA = 0
+	_, paramErr := fees.MinFee(bad, 300)
+	if !errors.As(paramErr, &pe) {
+		t.Fatalf("invalid params err = %T, want *ParamError", paramErr)
+	}
+	if errors.As(paramErr, &te) {
+		t.Error("a param error should not be a *TxFeeEstimationError")
+	}
+}
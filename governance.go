@@ -0,0 +1,215 @@
+package fees
+
+import (
+	"fmt"
+	"math"
+)
+
+// ProtocolParamUpdateByteEstimate returns the approximate size, in bytes,
+// of a CBOR-encoded protocol parameter update proposal that changes
+// numParamsChanged parameters: ~100 bytes for the proposal procedure
+// (deposit, return address, anchor) plus ~50 bytes per changed parameter.
+//
+// Example:
+//
+//	fees.ProtocolParamUpdateByteEstimate(3) // 250
+func ProtocolParamUpdateByteEstimate(numParamsChanged uint64) uint64 {
+	const (
+		proposalBaseBytes   uint64 = 100
+		bytesPerParamChange uint64 = 50
+	)
+	return proposalBaseBytes + bytesPerParamChange*numParamsChanged
+}
+
+// MinFeeForParamUpdate estimates the fee for a transaction that submits a
+// protocol parameter update governance action, using the structural byte
+// model for the inputs and outputs plus ProtocolParamUpdateByteEstimate.
+// The GovActionDeposit is not included; see TotalCostForParamUpdate.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeForParamUpdate(p, 1, 1, 3)
+//	// size = 200 + 140 + 65 + 250 = 655 bytes
+func MinFeeForParamUpdate(p ProtocolParams, numInputs, numOutputs, numParamsChanged uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return minFeeForParamUpdate(p, numInputs, numOutputs, numParamsChanged)
+}
+
+// minFeeForParamUpdate is MinFeeForParamUpdate without parameter
+// validation, for callers that have already validated p.
+func minFeeForParamUpdate(p ProtocolParams, numInputs, numOutputs, numParamsChanged uint64) (uint64, error) {
+	if numInputs == 0 {
+		return 0, NewFeeError("numInputs must be at least 1")
+	}
+	if numOutputs == 0 {
+		return 0, NewFeeError("numOutputs must be at least 1")
+	}
+	if numParamsChanged == 0 {
+		return 0, NewFeeError("numParamsChanged must be at least 1")
+	}
+	size := structuralTxSize(numInputs, numOutputs, false) + ProtocolParamUpdateByteEstimate(numParamsChanged)
+	return minFee(p, size)
+}
+
+// TotalCostForParamUpdate returns the fee from MinFeeForParamUpdate, the
+// refundable p.GovActionDeposit, and their sum: the Lovelace a proposer
+// must fund to submit the update.
+//
+// Returns a *ParamError if p.GovActionDeposit is zero.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, deposit, total, err := fees.TotalCostForParamUpdate(p, 1, 1, 3)
+//	// deposit = 100,000 ADA
+func TotalCostForParamUpdate(p ProtocolParams, numInputs, numOutputs, numParamsChanged uint64) (fee, deposit, total uint64, err error) {
+	if err := p.Validate(); err != nil {
+		return 0, 0, 0, err
+	}
+	if p.GovActionDeposit == 0 {
+		return 0, 0, 0, &ParamError{Field: "GovActionDeposit", Message: "must be non-zero for governance actions"}
+	}
+	fee, err = minFeeForParamUpdate(p, numInputs, numOutputs, numParamsChanged)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	total, err = AddLovelace(fee, p.GovActionDeposit)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return fee, p.GovActionDeposit, total, nil
+}
+
+// DRepVoteByteEstimate returns the approximate size, in bytes, of the
+// voting_procedures body field for a DRep casting numVotes votes, using
+// the EstimateConwayTxBodySize model: 3 bytes for the field plus 75 per
+// vote. The result saturates at math.MaxUint64, which no fee function
+// accepts.
+//
+// Example:
+//
+//	fees.DRepVoteByteEstimate(2) // 3 + 150 = 153
+func DRepVoteByteEstimate(numVotes uint64) uint64 {
+	size, err := addRepeatedField(0, votingProceduresFieldBytes, numVotes, votingProcedureBytes)
+	if err != nil {
+		return math.MaxUint64
+	}
+	return size
+}
+
+// DRepVoteFee estimates the fee for a transaction in which a DRep casts
+// numVotes votes, spending numInputs inputs to one change output. It is
+// MinFeeForConwayTx for numVotes voting procedures, except that the DRep
+// key signs once for all of them:
+//
+//	size = 200 + 140*numInputs + 65 + DRepVoteByteEstimate(numVotes) + 100
+//
+// With one input that is two witnesses, payment key and DRep key. Voting
+// requires no deposit.
+//
+// Returns a *FeeError if numVotes or numInputs is zero or the size
+// overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.DRepVoteFee(p, 1, 1)
+//	// size = 200 + 140 + 65 + 78 + 100 = 583 bytes
+func DRepVoteFee(p ProtocolParams, numVotes, numInputs uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	if numVotes == 0 {
+		return 0, NewFeeError("numVotes must be at least 1")
+	}
+	if numInputs == 0 {
+		return 0, NewFeeError("numInputs must be at least 1")
+	}
+	return minFeeForConwayTx(p, ConwayTxBodyComponents{
+		TxBodyComponents:    TxBodyComponents{NumInputs: numInputs, NumOutputs: 1},
+		NumVotingProcedures: numVotes,
+	}, 1)
+}
+
+// SimulateFeeChange shows how fees respond to a governance change of
+// MinFeeA. It returns a matrix indexed [minFeeAIndex][txSizeIndex]: the
+// fee for each sample transaction size under p with MinFeeA replaced by
+// each of minFeeAValues.
+//
+// Every MinFeeA value and transaction size is checked before any fee is
+// computed, so an error means no partial results. Returns an error if a
+// MinFeeA value makes p invalid or a size is zero or exceeds MaxTxSize.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	m, err := fees.SimulateFeeChange(p, []uint64{44, 50}, []uint64{300, 1_000})
+//	// m[1][0] = 50*300 + 155381
+func SimulateFeeChange(p ProtocolParams, minFeeAValues []uint64, sampleTxSizes []uint64) ([][]uint64, error) {
+	variants := make([]ProtocolParams, len(minFeeAValues))
+	for i, a := range minFeeAValues {
+		variants[i] = p.Copy()
+		variants[i].MinFeeA = a
+		if err := variants[i].Validate(); err != nil {
+			return nil, fmt.Errorf("MinFeeA %d: %w", a, err)
+		}
+		for _, size := range sampleTxSizes {
+			if err := checkTxSize(variants[i], size); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	matrix := make([][]uint64, len(variants))
+	for i, q := range variants {
+		matrix[i] = make([]uint64, len(sampleTxSizes))
+		for j, size := range sampleTxSizes {
+			fee, err := minFee(q, size)
+			if err != nil {
+				return nil, err
+			}
+			matrix[i][j] = fee
+		}
+	}
+	return matrix, nil
+}
+
+// SimulateMinUTxOChange is SimulateFeeChange for CoinsPerUTxOByte: it
+// returns a matrix indexed [coinsIndex][outputIndex] of the minUTxO of
+// each sample output under p with CoinsPerUTxOByte replaced by each of
+// coinsValues.
+//
+// Every CoinsPerUTxOByte value is checked before any minUTxO is computed.
+// Returns an error if a value makes p invalid.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	m, err := fees.SimulateMinUTxOChange(p, []uint64{4310, 5000},
+//		[]fees.OutputSize{{AddressBytes: 57}})
+func SimulateMinUTxOChange(p ProtocolParams, coinsValues []uint64, sampleOutputs []OutputSize) ([][]uint64, error) {
+	variants := make([]ProtocolParams, len(coinsValues))
+	for i, c := range coinsValues {
+		variants[i] = p.Copy()
+		variants[i].CoinsPerUTxOByte = c
+		if err := variants[i].Validate(); err != nil {
+			return nil, fmt.Errorf("CoinsPerUTxOByte %d: %w", c, err)
+		}
+	}
+
+	matrix := make([][]uint64, len(variants))
+	for i, q := range variants {
+		matrix[i] = make([]uint64, len(sampleOutputs))
+		for j, out := range sampleOutputs {
+			minADA, err := MinUTxO(q, out)
+			if err != nil {
+				return nil, err
+			}
+			matrix[i][j] = minADA
+		}
+	}
+	return matrix, nil
+}
@@ -0,0 +1,178 @@
+package fees_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestMinFeeForParamUpdate(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	if got := fees.ProtocolParamUpdateByteEstimate(3); got != 250 {
+		t.Errorf("ProtocolParamUpdateByteEstimate(3) = %d, want 250", got)
+	}
+
+	tests := []struct {
+		name                   string
+		inputs, outputs, param uint64
+		wantFee                uint64
+		wantErr                bool
+	}{
+		{"one param", 1, 1, 1, 44*(200+140+65+150) + 155381, false},
+		{"three params", 1, 1, 3, 44*(200+140+65+250) + 155381, false},
+		{"no params", 1, 1, 0, 0, true},
+		{"no inputs", 0, 1, 1, 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MinFeeForParamUpdate(p, tc.inputs, tc.outputs, tc.param)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.wantFee {
+				t.Errorf("fee = %d, want %d", got, tc.wantFee)
+			}
+		})
+	}
+}
+
+func TestTotalCostForParamUpdate(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	fee, deposit, total, err := fees.TotalCostForParamUpdate(p, 1, 1, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantFee, _ := fees.MinFeeForParamUpdate(p, 1, 1, 3)
+	if fee != wantFee || deposit != p.GovActionDeposit || total != fee+deposit {
+		t.Errorf("got (%d, %d, %d), want (%d, %d, %d)", fee, deposit, total, wantFee, p.GovActionDeposit, wantFee+p.GovActionDeposit)
+	}
+
+	p.GovActionDeposit = 0
+	_, _, _, err = fees.TotalCostForParamUpdate(p, 1, 1, 3)
+	var pe *fees.ParamError
+	if !errors.As(err, &pe) || pe.Field != "GovActionDeposit" {
+		t.Errorf("err = %v, want *ParamError for GovActionDeposit", err)
+	}
+}
+
+func TestSimulateFeeChange(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	sizes := []uint64{300, 1_000, 16_384}
+	values := []uint64{44, 50}
+
+	m, err := fees.SimulateFeeChange(p, values, sizes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != len(values) {
+		t.Fatalf("len(m) = %d, want %d", len(m), len(values))
+	}
+	for i, a := range values {
+		if len(m[i]) != len(sizes) {
+			t.Fatalf("len(m[%d]) = %d, want %d", i, len(m[i]), len(sizes))
+		}
+		for j, size := range sizes {
+			if want := a*size + p.MinFeeB; m[i][j] != want {
+				t.Errorf("m[%d][%d] = %d, want %d", i, j, m[i][j], want)
+			}
+		}
+	}
+
+	if _, err := fees.SimulateFeeChange(p, []uint64{44, 0}, sizes); !errors.Is(err, fees.ErrInvalidMinFeeA) {
+		t.Errorf("zero MinFeeA err = %v, want ErrInvalidMinFeeA", err)
+	}
+	if _, err := fees.SimulateFeeChange(p, values, []uint64{300, 20_000}); !errors.Is(err, fees.ErrTxTooLarge) {
+		t.Errorf("oversized tx err = %v, want ErrTxTooLarge", err)
+	}
+}
+
+func TestSimulateMinUTxOChange(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	outputs := []fees.OutputSize{
+		{AddressBytes: 57},
+		{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8},
+	}
+	values := []uint64{4_310, 5_000}
+
+	m, err := fees.SimulateMinUTxOChange(p, values, outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, c := range values {
+		q := p
+		q.CoinsPerUTxOByte = c
+		for j, out := range outputs {
+			if want, _ := fees.MinUTxO(q, out); m[i][j] != want {
+				t.Errorf("m[%d][%d] = %d, want %d", i, j, m[i][j], want)
+			}
+		}
+	}
+
+	if _, err := fees.SimulateMinUTxOChange(p, []uint64{0}, outputs); !errors.Is(err, fees.ErrInvalidCoinsPerUTxOByte) {
+		t.Errorf("zero CoinsPerUTxOByte err = %v, want ErrInvalidCoinsPerUTxOByte", err)
+	}
+}
+
+func TestDRepVoteFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	if got := fees.DRepVoteByteEstimate(2); got != 153 {
+		t.Errorf("DRepVoteByteEstimate(2) = %d, want 153", got)
+	}
+	if got := fees.DRepVoteByteEstimate(math.MaxUint64 / 10); got != math.MaxUint64 {
+		t.Errorf("DRepVoteByteEstimate overflow = %d, want MaxUint64", got)
+	}
+
+	tests := []struct {
+		name     string
+		votes    uint64
+		inputs   uint64
+		wantSize uint64
+	}{
+		{"one vote", 1, 1, 583},
+		{"three votes two inputs", 3, 2, 200 + 280 + 65 + 228 + 100},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.DRepVoteFee(p, tc.votes, tc.inputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want, _ := fees.MinFee(p, tc.wantSize); got != want {
+				t.Errorf("DRepVoteFee = %d, want MinFee(%d) = %d", got, tc.wantSize, want)
+			}
+			plain, _ := fees.EstimateFee(p, tc.inputs, 1, false)
+			if got <= plain {
+				t.Errorf("DRepVoteFee = %d, want above the ADA-only fee %d", got, plain)
+			}
+		})
+	}
+
+	if _, err := fees.DRepVoteFee(p, 0, 1); err == nil {
+		t.Error("expected error for zero votes")
+	}
+	if _, err := fees.DRepVoteFee(p, 1, 0); err == nil {
+		t.Error("expected error for zero inputs")
+	}
+
+	// One vote is priced the same as by MinFeeForConwayTx.
+	one, _ := fees.DRepVoteFee(p, 1, 1)
+	conway, _ := fees.MinFeeForConwayTx(p, fees.ConwayTxBodyComponents{
+		TxBodyComponents:    fees.TxBodyComponents{NumInputs: 1, NumOutputs: 1},
+		NumVotingProcedures: 1,
+	})
+	if one != conway {
+		t.Errorf("DRepVoteFee(1, 1) = %d, want MinFeeForConwayTx %d", one, conway)
+	}
+
+	var fe *fees.FeeError
+	if _, err := fees.DRepVoteFee(p, 4_854_406_335_186_724_120, 1); !errors.As(err, &fe) {
+		t.Errorf("err = %v, want *FeeError for overflowing votes", err)
+	}
+}
@@ -0,0 +1,87 @@
+package fees
+
+import "fmt"
+
+// InputType identifies how a transaction input is authorised, which
+// determines what it adds to the witness set alongside its ~40-byte TxIn
+// reference.
+type InputType uint8
+
+const (
+	// InputTypeKeyWitness is spent from a key-hash address and signed with
+	// one ~100-byte VKey witness.
+	InputTypeKeyWitness InputType = iota
+
+	// InputTypeNativeScript is spent from a native-script address. It
+	// carries the script and, as native scripts almost always require a
+	// signer, one VKey witness.
+	InputTypeNativeScript
+
+	// InputTypePlutusScript is spent from a Plutus-script address. It
+	// carries the script and a redeemer instead of a VKey witness.
+	InputTypePlutusScript
+)
+
+// String returns the input type's name, e.g. "key" or "plutus".
+func (t InputType) String() string {
+	switch t {
+	case InputTypeKeyWitness:
+		return "key"
+	case InputTypeNativeScript:
+		return "native"
+	case InputTypePlutusScript:
+		return "plutus"
+	default:
+		return fmt.Sprintf("InputType(%d)", uint8(t))
+	}
+}
+
+// InputByteEstimate returns the bytes one input of type t adds to a
+// transaction:
+//
+//	key:    40 + 100
+//	native: 40 + scriptBytes + 100
+//	plutus: 40 + scriptBytes + redeemerBytes
+//
+// scriptBytes and redeemerBytes are ignored where they do not apply; pass
+// zero scriptBytes for scripts supplied by reference. Unknown types are
+// sized as key-witnessed inputs.
+//
+// Example:
+//
+//	fees.InputByteEstimate(fees.InputTypePlutusScript, 2_000, 50) // 2,090
+func InputByteEstimate(t InputType, scriptBytes, redeemerBytes uint64) uint64 {
+	switch t {
+	case InputTypeNativeScript:
+		return txInBytes + scriptBytes + vkeyWitnessBytes
+	case InputTypePlutusScript:
+		return txInBytes + scriptBytes + redeemerBytes
+	default:
+		return bytesPerInput
+	}
+}
+
+// EstimateTxSizeWithMixedInputs estimates the size of a transaction that
+// spends keyInputs key-witnessed inputs plus one input of each type in
+// scriptInputs, each script input sized with scriptBytesPerInput and
+// redeemerBytesPerInput, and that has numOutputs outputs and no metadata.
+// If any input is a Plutus script input, the ScriptDataHashBytes body
+// field is included.
+//
+// Example:
+//
+//	size := fees.EstimateTxSizeWithMixedInputs(1,
+//		[]fees.InputType{fees.InputTypePlutusScript}, 2_000, 50, 2)
+//	// 200 + 140 + 2,090 + 2*65 + 34 = 2,594
+func EstimateTxSizeWithMixedInputs(keyInputs uint64, scriptInputs []InputType, scriptBytesPerInput, redeemerBytesPerInput uint64, numOutputs uint64) uint64 {
+	size := structuralTxSize(keyInputs, numOutputs, false)
+	hasPlutus := false
+	for _, t := range scriptInputs {
+		size += InputByteEstimate(t, scriptBytesPerInput, redeemerBytesPerInput)
+		hasPlutus = hasPlutus || t == InputTypePlutusScript
+	}
+	if hasPlutus {
+		size += ScriptDataHashBytes()
+	}
+	return size
+}
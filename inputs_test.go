@@ -0,0 +1,54 @@
+package fees_test
+
+import (
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestInputByteEstimate(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        fees.InputType
+		script   uint64
+		redeemer uint64
+		want     uint64
+	}{
+		{"key ignores script bytes", fees.InputTypeKeyWitness, 2_000, 50, 140},
+		{"native", fees.InputTypeNativeScript, 100, 50, 240},
+		{"plutus", fees.InputTypePlutusScript, 2_000, 50, 2_090},
+		{"plutus by reference", fees.InputTypePlutusScript, 0, 50, 90},
+		{"unknown", fees.InputType(9), 2_000, 50, 140},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fees.InputByteEstimate(tc.t, tc.script, tc.redeemer); got != tc.want {
+				t.Errorf("InputByteEstimate(%v) = %d, want %d", tc.t, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEstimateTxSizeWithMixedInputs(t *testing.T) {
+	got := fees.EstimateTxSizeWithMixedInputs(1, []fees.InputType{
+		fees.InputTypePlutusScript,
+		fees.InputTypeNativeScript,
+	}, 2_000, 50, 2)
+	want := uint64(200 + 140 + 2_090 + 2_140 + 2*65 + 34)
+	if got != want {
+		t.Errorf("EstimateTxSizeWithMixedInputs = %d, want %d", got, want)
+	}
+
+	// With only key inputs it matches the structural estimate.
+	keyOnly := fees.EstimateTxSizeWithMixedInputs(2, nil, 0, 0, 2)
+	if keyOnly != 200+2*140+2*65 {
+		t.Errorf("key-only size = %d", keyOnly)
+	}
+
+	// Native scripts have no script_data_hash.
+	native := fees.EstimateTxSizeWithMixedInputs(0, []fees.InputType{fees.InputTypeNativeScript}, 100, 0, 1)
+	if native != 200+240+65 {
+		t.Errorf("native-only size = %d", native)
+	}
+}
@@ -0,0 +1,60 @@
+package fees
+
+import "encoding/json"
+
+// OutputSizeJSON is the JSON form of OutputSize, with camelCase keys. It
+// mirrors OutputSize field for field, so the two convert directly.
+//
+// Example:
+//
+//	{"addressBytes": 57, "numPolicies": 1, "numAssets": 1, "totalAssetNameBytes": 8}
+type OutputSizeJSON struct {
+	AddressBytes        uint64 `json:"addressBytes"`
+	NumPolicies         uint64 `json:"numPolicies"`
+	NumAssets           uint64 `json:"numAssets"`
+	TotalAssetNameBytes uint64 `json:"totalAssetNameBytes"`
+	HasDatumHash        bool   `json:"hasDatumHash"`
+	HasInlineDatum      bool   `json:"hasInlineDatum"`
+	InlineDatumBytes    uint64 `json:"inlineDatumBytes"`
+	HasScriptRef        bool   `json:"hasScriptRef"`
+	ScriptRefBytes      uint64 `json:"scriptRefBytes"`
+}
+
+// MarshalJSON encodes out as an OutputSizeJSON object.
+//
+// Example:
+//
+//	data, err := json.Marshal(fees.OutputSize{AddressBytes: 57})
+func (out OutputSize) MarshalJSON() ([]byte, error) {
+	return json.Marshal(OutputSizeJSON(out))
+}
+
+// UnmarshalJSON decodes an OutputSizeJSON object into out. Missing keys
+// are left at zero; the result is not validated.
+//
+// Example:
+//
+//	var out fees.OutputSize
+//	err := json.Unmarshal(data, &out)
+func (out *OutputSize) UnmarshalJSON(data []byte) error {
+	var j OutputSizeJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	*out = OutputSize(j)
+	return nil
+}
+
+// OutputSizeFromJSON parses an OutputSizeJSON object. Call Validate on the
+// result to check it for inconsistent fields.
+//
+// Example:
+//
+//	out, err := fees.OutputSizeFromJSON([]byte(`{"addressBytes": 57}`))
+func OutputSizeFromJSON(data []byte) (OutputSize, error) {
+	var out OutputSize
+	if err := json.Unmarshal(data, &out); err != nil {
+		return OutputSize{}, err
+	}
+	return out, nil
+}
@@ -0,0 +1,88 @@
+package fees_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestOutputSizeFromJSON(t *testing.T) {
+	data, err := os.ReadFile("testdata/output_size.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := fees.OutputSizeFromJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fees.OutputSize{
+		AddressBytes:        57,
+		NumPolicies:         2,
+		NumAssets:           3,
+		TotalAssetNameBytes: 24,
+		HasInlineDatum:      true,
+		InlineDatumBytes:    120,
+		HasScriptRef:        true,
+		ScriptRefBytes:      800,
+	}
+	if out != want {
+		t.Errorf("got %+v, want %+v", out, want)
+	}
+	if err := out.Validate(); err != nil {
+		t.Errorf("fixture should be a valid output: %v", err)
+	}
+
+	if _, err := fees.OutputSizeFromJSON([]byte(`{"addressBytes": -1}`)); err == nil {
+		t.Error("expected error for negative addressBytes")
+	}
+	if _, err := fees.OutputSizeFromJSON([]byte(`not json`)); err == nil {
+		t.Error("expected error for malformed JSON")
+	}
+}
+
+func TestOutputSizeJSONRoundTrip(t *testing.T) {
+	// Every field non-zero, so a dropped or misnamed key shows up.
+	out := fees.OutputSize{
+		AddressBytes:        57,
+		NumPolicies:         1,
+		NumAssets:           2,
+		TotalAssetNameBytes: 16,
+		HasDatumHash:        true,
+		HasInlineDatum:      true,
+		InlineDatumBytes:    64,
+		HasScriptRef:        true,
+		ScriptRefBytes:      512,
+	}
+	data, err := json.Marshal(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"addressBytes":57,"numPolicies":1,"numAssets":2,"totalAssetNameBytes":16,"hasDatumHash":true,"hasInlineDatum":true,"inlineDatumBytes":64,"hasScriptRef":true,"scriptRefBytes":512}`
+	if string(data) != want {
+		t.Errorf("Marshal = %s, want %s", data, want)
+	}
+
+	var got fees.OutputSize
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != out {
+		t.Errorf("round trip = %+v, want %+v", got, out)
+	}
+
+	// OutputSize marshals the same way inside other values.
+	outputs := []fees.OutputSize{out, {AddressBytes: 29}}
+	data, err = json.Marshal(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gotOutputs []fees.OutputSize
+	if err := json.Unmarshal(data, &gotOutputs); err != nil {
+		t.Fatal(err)
+	}
+	if len(gotOutputs) != 2 || gotOutputs[0] != outputs[0] || gotOutputs[1] != outputs[1] {
+		t.Errorf("slice round trip = %+v, want %+v", gotOutputs, outputs)
+	}
+}
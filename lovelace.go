@@ -3,11 +3,23 @@ package fees
 import (
 	"fmt"
 	"math"
+	"math/bits"
+	"strconv"
 )
 
 const (
 	// LovelacePerADA is the number of Lovelace in one ADA.
 	LovelacePerADA uint64 = 1_000_000
+
+	// MicroLovelacePerLovelace is the number of micro-Lovelace in one
+	// Lovelace. Micro-Lovelace are not an on-chain unit; they give
+	// intermediate calculations (yields, pro-rata splits) six extra decimal
+	// places before the result is truncated back to whole Lovelace.
+	MicroLovelacePerLovelace uint64 = 1_000_000
+
+	// MaxSupplyLovelace is the fixed maximum ADA supply, 45 billion ADA, in
+	// Lovelace. No output can hold more.
+	MaxSupplyLovelace uint64 = 45_000_000_000 * LovelacePerADA
 )
 
 // ToLovelace converts an ADA amount (as a float64) to Lovelace (uint64),
@@ -42,6 +54,58 @@ func ToADA(lovelace uint64) float64 {
 	return float64(lovelace) / float64(LovelacePerADA)
 }
 
+// ToADAComponents splits a Lovelace amount into whole ADA and the
+// remaining fractional part in Lovelace (0–999,999), without going through
+// float64.
+//
+// Example:
+//
+//	whole, frac := fees.ToADAComponents(1_310_000) // 1, 310_000
+func ToADAComponents(lovelace uint64) (intPart, fracPart uint64) {
+	return lovelace / LovelacePerADA, lovelace % LovelacePerADA
+}
+
+// ToLovelaceExact is the inverse of ToADAComponents: it returns
+// intPart*1,000,000 + fracPart using integer arithmetic only, for
+// financial code that must avoid floating point.
+//
+// Returns an error if fracPart is 1,000,000 or more, or if the result would
+// overflow uint64.
+//
+// Example:
+//
+//	lv, err := fees.ToLovelaceExact(1, 310_000) // 1_310_000
+func ToLovelaceExact(intPart, fracPart uint64) (uint64, error) {
+	if fracPart >= LovelacePerADA {
+		return 0, fmt.Errorf("fees: ToLovelaceExact: fracPart %d must be less than %d", fracPart, LovelacePerADA)
+	}
+	hi, whole := bits.Mul64(intPart, LovelacePerADA)
+	sum, carry := bits.Add64(whole, fracPart, 0)
+	if hi != 0 || carry != 0 {
+		return 0, fmt.Errorf("fees: ToLovelaceExact: %d ADA overflows uint64", intPart)
+	}
+	return sum, nil
+}
+
+// IsLovelaceExactlyRepresentable reports whether lovelace survives a
+// round trip through float64 ADA: the ADA value returned by ToADA is split
+// into whole and fractional parts and rebuilt with ToLovelaceExact. It is
+// true for amounts up to 2^52 Lovelace (~4.5 billion ADA); above that,
+// float64 can no longer hold six decimal places and some amounts report
+// false. Use ToADAComponents and ToLovelaceExact when the answer is false.
+//
+// Example:
+//
+//	fees.IsLovelaceExactlyRepresentable(1_310_000)      // true
+//	fees.IsLovelaceExactlyRepresentable(math.MaxUint64) // false
+func IsLovelaceExactlyRepresentable(lovelace uint64) bool {
+	ada := ToADA(lovelace)
+	whole := math.Trunc(ada)
+	frac := math.Round((ada - whole) * float64(LovelacePerADA))
+	got, err := ToLovelaceExact(uint64(whole), uint64(frac))
+	return err == nil && got == lovelace
+}
+
 // FormatADA formats a Lovelace amount as a human-readable ADA string
 // with 6 decimal places.
 //
@@ -52,6 +116,45 @@ func FormatADA(lovelace uint64) string {
 	return fmt.Sprintf("%.6f ADA", ToADA(lovelace))
 }
 
+// ToADAString formats a Lovelace amount as an ADA decimal string with
+// exactly 6 decimal places, using integer arithmetic so that no precision
+// is lost for any uint64 value (unlike formatting the float64 from ToADA).
+//
+// Example:
+//
+//	fees.ToADAString(170_781)       // "0.170781"
+//	fees.ToADAString(1_500_000_000) // "1500.000000"
+func ToADAString(lovelace uint64) string {
+	return fmt.Sprintf("%d.%06d", lovelace/LovelacePerADA, lovelace%LovelacePerADA)
+}
+
+// FormatADARange formats a range of Lovelace amounts in ADA for display,
+// e.g. a fee range in a UI. When min equals max a single value is shown.
+//
+// Example:
+//
+//	fees.FormatADARange(170_781, 200_000) // "0.170781–0.200000 ADA"
+//	fees.FormatADARange(170_781, 170_781) // "0.170781 ADA"
+func FormatADARange(minLovelace, maxLovelace uint64) string {
+	if minLovelace == maxLovelace {
+		return ToADAString(minLovelace) + " ADA"
+	}
+	return ToADAString(minLovelace) + "–" + ToADAString(maxLovelace) + " ADA"
+}
+
+// FormatLovelaceRange formats a range of Lovelace amounts for display.
+// When min equals max a single value is shown.
+//
+// Example:
+//
+//	fees.FormatLovelaceRange(170_781, 200_000) // "170781–200000 Lovelace"
+func FormatLovelaceRange(min, max uint64) string {
+	if min == max {
+		return FormatLovelace(min)
+	}
+	return fmt.Sprintf("%d–%d Lovelace", min, max)
+}
+
 // FormatLovelace formats a uint64 Lovelace value as a string with the
 // unit suffix for display purposes.
 //
@@ -62,6 +165,177 @@ func FormatLovelace(lovelace uint64) string {
 	return fmt.Sprintf("%d Lovelace", lovelace)
 }
 
+// FormatLovelaceWithSeparator formats a Lovelace value with thousands
+// separators and the unit suffix for display purposes.
+//
+// Example:
+//
+//	fees.FormatLovelaceWithSeparator(1_310_000) // "1,310,000 Lovelace"
+func FormatLovelaceWithSeparator(lovelace uint64) string {
+	return formatThousands(lovelace) + " Lovelace"
+}
+
+// FormatLovelaceDiff formats the change from before to after with an
+// explicit sign, in Lovelace and ADA, for comparing fees across a
+// parameter change. No change is formatted without a sign.
+//
+// Example:
+//
+//	fees.FormatLovelaceDiff(170_000, 214_000) // "+44,000 Lovelace (+0.044000 ADA)"
+//	fees.FormatLovelaceDiff(214_000, 170_000) // "-44,000 Lovelace (-0.044000 ADA)"
+func FormatLovelaceDiff(before, after uint64) string {
+	sign, delta := lovelaceDiff(before, after)
+	return fmt.Sprintf("%s%s (%s%s ADA)", sign, FormatLovelaceWithSeparator(delta), sign, ToADAString(delta))
+}
+
+// FormatADADiff is FormatLovelaceDiff showing only the ADA difference.
+//
+// Example:
+//
+//	fees.FormatADADiff(170_000, 214_000) // "+0.044000 ADA"
+func FormatADADiff(before, after uint64) string {
+	sign, delta := lovelaceDiff(before, after)
+	return sign + ToADAString(delta) + " ADA"
+}
+
+// lovelaceDiff returns the sign ("+", "-", or "" for no change) and
+// magnitude of after - before.
+func lovelaceDiff(before, after uint64) (sign string, delta uint64) {
+	switch {
+	case after > before:
+		return "+", after - before
+	case after < before:
+		return "-", before - after
+	default:
+		return "", 0
+	}
+}
+
+// formatThousands formats n in base 10 with a comma between each group of
+// three digits, e.g. 1310000 -> "1,310,000".
+func formatThousands(n uint64) string {
+	s := strconv.FormatUint(n, 10)
+	if len(s) <= 3 {
+		return s
+	}
+	out := make([]byte, 0, len(s)+(len(s)-1)/3)
+	for i := 0; i < len(s); i++ {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			out = append(out, ',')
+		}
+		out = append(out, s[i])
+	}
+	return string(out)
+}
+
+// ToMicroLovelace converts Lovelace to micro-Lovelace, returning an error
+// if the result would overflow uint64. Amounts above
+// math.MaxUint64/MicroLovelacePerLovelace (about 18.4 trillion Lovelace)
+// have no uint64 micro-Lovelace value, so FromMicroLovelace(ToMicroLovelace(n))
+// == n holds for every n that converts without error.
+//
+// Example:
+//
+//	micro, err := fees.ToMicroLovelace(1_500) // 1_500_000_000
+func ToMicroLovelace(lovelace uint64) (uint64, error) {
+	if lovelace > math.MaxUint64/MicroLovelacePerLovelace {
+		return 0, fmt.Errorf("fees: ToMicroLovelace: %d Lovelace overflows uint64", lovelace)
+	}
+	return lovelace * MicroLovelacePerLovelace, nil
+}
+
+// FromMicroLovelace converts micro-Lovelace to Lovelace, truncating any
+// fractional Lovelace.
+//
+// Example:
+//
+//	lv := fees.FromMicroLovelace(1_500_999_999) // 1_500
+func FromMicroLovelace(micro uint64) uint64 {
+	return micro / MicroLovelacePerLovelace
+}
+
+// ScaleLovelaceByMicro multiplies lovelace by microFactor parts-per-million
+// and truncates the result to whole Lovelace:
+//
+//	result = lovelace * microFactor / 1_000_000
+//
+// The intermediate product is computed at 128-bit precision, so only a
+// final result that overflows uint64 is an error.
+//
+// Example:
+//
+//	lv, err := fees.ScaleLovelaceByMicro(10_000_000, 25_000) // 2.5% of 10 ADA = 250_000
+func ScaleLovelaceByMicro(lovelace, microFactor uint64) (uint64, error) {
+	hi, lo := bits.Mul64(lovelace, microFactor)
+	if hi >= MicroLovelacePerLovelace {
+		return 0, fmt.Errorf("fees: ScaleLovelaceByMicro: %d * %d ppm overflows uint64", lovelace, microFactor)
+	}
+	quo, _ := bits.Div64(hi, lo, MicroLovelacePerLovelace)
+	return quo, nil
+}
+
+// RoundLovelaceToNearest rounds lovelace to the nearest multiple of
+// increment, for display such as "~0.171 ADA". An amount exactly halfway
+// between two multiples rounds up.
+//
+// Returns an error if increment is zero or the result overflows uint64.
+//
+// Example:
+//
+//	lv, err := fees.RoundLovelaceToNearest(170_781, 1_000) // 171_000
+//	lv, err := fees.RoundLovelaceToNearest(170_500, 1_000) // 171_000
+//	lv, err := fees.RoundLovelaceToNearest(170_499, 1_000) // 170_000
+func RoundLovelaceToNearest(lovelace, increment uint64) (uint64, error) {
+	if increment == 0 {
+		return 0, fmt.Errorf("fees: RoundLovelaceToNearest: increment must be greater than zero")
+	}
+	// Compare the remainder with the distance to the next multiple rather
+	// than adding increment/2, which could overflow.
+	if rem := lovelace % increment; rem < increment-rem {
+		return lovelace - rem, nil
+	}
+	return RoundLovelaceUpToNearest(lovelace, increment)
+}
+
+// RoundLovelaceUpToNearest rounds lovelace up to the next multiple of
+// increment, leaving exact multiples unchanged. Use it for amounts that
+// must not be under-stated, such as fee reserves.
+//
+// Returns an error if increment is zero or the result overflows uint64.
+//
+// Example:
+//
+//	lv, err := fees.RoundLovelaceUpToNearest(170_001, 1_000) // 171_000
+func RoundLovelaceUpToNearest(lovelace, increment uint64) (uint64, error) {
+	if increment == 0 {
+		return 0, fmt.Errorf("fees: RoundLovelaceUpToNearest: increment must be greater than zero")
+	}
+	rem := lovelace % increment
+	if rem == 0 {
+		return lovelace, nil
+	}
+	sum, carry := bits.Add64(lovelace, increment-rem, 0)
+	if carry != 0 {
+		return 0, fmt.Errorf("fees: RoundLovelaceUpToNearest: rounding %d up to a multiple of %d overflows uint64", lovelace, increment)
+	}
+	return sum, nil
+}
+
+// RoundLovelaceDownToNearest rounds lovelace down to the previous multiple
+// of increment, leaving exact multiples unchanged.
+//
+// Returns an error if increment is zero.
+//
+// Example:
+//
+//	lv, err := fees.RoundLovelaceDownToNearest(170_999, 1_000) // 170_000
+func RoundLovelaceDownToNearest(lovelace, increment uint64) (uint64, error) {
+	if increment == 0 {
+		return 0, fmt.Errorf("fees: RoundLovelaceDownToNearest: increment must be greater than zero")
+	}
+	return lovelace - lovelace%increment, nil
+}
+
 // AddLovelace safely adds two Lovelace values, returning an error on overflow.
 //
 // Example:
@@ -105,4 +379,4 @@ func IsAboveMinUTxO(p ProtocolParams, lovelace uint64, out OutputSize) (bool, ui
 		return false, 0, err
 	}
 	return lovelace >= required, required, nil
-}
\ No newline at end of file
+}
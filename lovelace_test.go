@@ -1,6 +1,7 @@
 package fees_test
 
 import (
+	"math"
 	"testing"
 
 	fees "github.com/njchilds90/go-cardano-fees"
@@ -41,7 +42,7 @@ func TestToLovelace(t *testing.T) {
 
 func TestToADA(t *testing.T) {
 	tests := []struct {
-		lovedace uint64
+		lovelace uint64
 		want     float64
 	}{
 		{1_000_000, 1.0},
@@ -108,4 +109,249 @@ func TestLovelacePerADA(t *testing.T) {
 	if fees.LovelacePerADA != 1_000_000 {
 		 t.Errorf("LovelacePerADA should be 1000000, got %d", fees.LovelacePerADA)
 	}
-}
\ No newline at end of file
+}
+
+func TestFormatLovelaceWithSeparator(t *testing.T) {
+	tests := []struct {
+		lovelace uint64
+		want     string
+	}{
+		{0, "0 Lovelace"},
+		{999, "999 Lovelace"},
+		{1_000, "1,000 Lovelace"},
+		{155_381, "155,381 Lovelace"},
+		{1_310_000, "1,310,000 Lovelace"},
+		{18_446_744_073_709_551_615, "18,446,744,073,709,551,615 Lovelace"},
+	}
+
+	for _, tc := range tests {
+		got := fees.FormatLovelaceWithSeparator(tc.lovelace)
+		if got != tc.want {
+			t.Errorf("FormatLovelaceWithSeparator(%d) = %q, want %q", tc.lovelace, got, tc.want)
+		}
+	}
+}
+
+func TestMicroLovelaceRoundTrip(t *testing.T) {
+	maxMicro := uint64(math.MaxUint64) / fees.MicroLovelacePerLovelace
+	values := []uint64{0, 1, 999, 1_000_000, maxMicro - 1, maxMicro}
+	for n := uint64(0); n < 10_000; n++ {
+		values = append(values, n, maxMicro-n)
+	}
+
+	for _, n := range values {
+		micro, err := fees.ToMicroLovelace(n)
+		if err != nil {
+			t.Fatalf("ToMicroLovelace(%d): %v", n, err)
+		}
+		if got := fees.FromMicroLovelace(micro); got != n {
+			t.Fatalf("FromMicroLovelace(ToMicroLovelace(%d)) = %d", n, got)
+		}
+	}
+
+	if _, err := fees.ToMicroLovelace(maxMicro + 1); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func TestFromMicroLovelaceTruncates(t *testing.T) {
+	if got := fees.FromMicroLovelace(1_500_999_999); got != 1_500 {
+		t.Errorf("got %d, want 1500", got)
+	}
+}
+
+func TestScaleLovelaceByMicro(t *testing.T) {
+	tests := []struct {
+		name     string
+		lovelace uint64
+		factor   uint64
+		want     uint64
+		wantErr  bool
+	}{
+		{"identity", 1_234_567, 1_000_000, 1_234_567, false},
+		{"2.5 percent", 10_000_000, 25_000, 250_000, false},
+		{"truncates", 3, 500_000, 1, false},
+		{"zero factor", 1_000_000, 0, 0, false},
+		{"large intermediate", math.MaxUint64, 1_000_000, math.MaxUint64, false},
+		{"overflow", math.MaxUint64, 2_000_000, 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.ScaleLovelaceByMicro(tc.lovelace, tc.factor)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestToADAString(t *testing.T) {
+	tests := []struct {
+		lovelace uint64
+		want     string
+	}{
+		{0, "0.000000"},
+		{1, "0.000001"},
+		{170_781, "0.170781"},
+		{1_500_000_000, "1500.000000"},
+		{math.MaxUint64, "18446744073709.551615"},
+	}
+
+	for _, tc := range tests {
+		if got := fees.ToADAString(tc.lovelace); got != tc.want {
+			t.Errorf("ToADAString(%d) = %q, want %q", tc.lovelace, got, tc.want)
+		}
+	}
+}
+
+func TestFormatRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		min, max uint64
+		wantADA  string
+		wantLove string
+	}{
+		{"range", 170_781, 200_000, "0.170781–0.200000 ADA", "170781–200000 Lovelace"},
+		{"single value", 170_781, 170_781, "0.170781 ADA", "170781 Lovelace"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fees.FormatADARange(tc.min, tc.max); got != tc.wantADA {
+				t.Errorf("FormatADARange = %q, want %q", got, tc.wantADA)
+			}
+			if got := fees.FormatLovelaceRange(tc.min, tc.max); got != tc.wantLove {
+				t.Errorf("FormatLovelaceRange = %q, want %q", got, tc.wantLove)
+			}
+		})
+	}
+}
+
+func TestFormatLovelaceDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after uint64
+		want          string
+		wantADA       string
+	}{
+		{"increase", 170_000, 214_000, "+44,000 Lovelace (+0.044000 ADA)", "+0.044000 ADA"},
+		{"decrease", 214_000, 170_000, "-44,000 Lovelace (-0.044000 ADA)", "-0.044000 ADA"},
+		{"no change", 170_000, 170_000, "0 Lovelace (0.000000 ADA)", "0.000000 ADA"},
+		{"large", 0, math.MaxUint64, "+18,446,744,073,709,551,615 Lovelace (+18446744073709.551615 ADA)", "+18446744073709.551615 ADA"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fees.FormatLovelaceDiff(tc.before, tc.after); got != tc.want {
+				t.Errorf("FormatLovelaceDiff = %q, want %q", got, tc.want)
+			}
+			if got := fees.FormatADADiff(tc.before, tc.after); got != tc.wantADA {
+				t.Errorf("FormatADADiff = %q, want %q", got, tc.wantADA)
+			}
+		})
+	}
+}
+
+func TestADAComponents(t *testing.T) {
+	tests := []struct {
+		lovelace   uint64
+		whole      uint64
+		frac       uint64
+		exactFloat bool
+	}{
+		{0, 0, 0, true},
+		{1, 0, 1, true},
+		{1_310_000, 1, 310_000, true},
+		{290_000, 0, 290_000, true},
+		{1 << 52, 4_503_599_627, 370_496, true},
+		{45_000_000_000_000_001, 45_000_000_000, 1, false},
+		{math.MaxUint64, 18_446_744_073_709, 551_615, false},
+	}
+
+	for _, tc := range tests {
+		whole, frac := fees.ToADAComponents(tc.lovelace)
+		if whole != tc.whole || frac != tc.frac {
+			t.Errorf("ToADAComponents(%d) = %d, %d; want %d, %d", tc.lovelace, whole, frac, tc.whole, tc.frac)
+		}
+		back, err := fees.ToLovelaceExact(whole, frac)
+		if err != nil || back != tc.lovelace {
+			t.Errorf("ToLovelaceExact(%d, %d) = %d, %v; want %d", whole, frac, back, err, tc.lovelace)
+		}
+		if got := fees.IsLovelaceExactlyRepresentable(tc.lovelace); got != tc.exactFloat {
+			t.Errorf("IsLovelaceExactlyRepresentable(%d) = %v, want %v", tc.lovelace, got, tc.exactFloat)
+		}
+	}
+}
+
+func TestToLovelaceExactErrors(t *testing.T) {
+	if _, err := fees.ToLovelaceExact(1, 1_000_000); err == nil {
+		t.Error("expected error for fracPart >= 1,000,000")
+	}
+	if _, err := fees.ToLovelaceExact(18_446_744_073_709, 551_616); err == nil {
+		t.Error("expected overflow error")
+	}
+	if _, err := fees.ToLovelaceExact(math.MaxUint64, 0); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func TestRoundLovelace(t *testing.T) {
+	tests := []struct {
+		name      string
+		lovelace  uint64
+		increment uint64
+		nearest   uint64
+		up        uint64
+		down      uint64
+	}{
+		{"exact multiple", 170_000, 1_000, 170_000, 170_000, 170_000},
+		{"below half", 170_499, 1_000, 170_000, 171_000, 170_000},
+		{"exactly half", 170_500, 1_000, 171_000, 171_000, 170_000},
+		{"above half", 170_781, 1_000, 171_000, 171_000, 170_000},
+		{"odd increment half", 7, 3, 6, 9, 6},
+		{"increment of one", 170_781, 1, 170_781, 170_781, 170_781},
+		{"zero", 0, 1_000, 0, 0, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got, err := fees.RoundLovelaceToNearest(tc.lovelace, tc.increment); err != nil || got != tc.nearest {
+				t.Errorf("RoundLovelaceToNearest = %d, %v; want %d", got, err, tc.nearest)
+			}
+			if got, err := fees.RoundLovelaceUpToNearest(tc.lovelace, tc.increment); err != nil || got != tc.up {
+				t.Errorf("RoundLovelaceUpToNearest = %d, %v; want %d", got, err, tc.up)
+			}
+			if got, err := fees.RoundLovelaceDownToNearest(tc.lovelace, tc.increment); err != nil || got != tc.down {
+				t.Errorf("RoundLovelaceDownToNearest = %d, %v; want %d", got, err, tc.down)
+			}
+		})
+	}
+}
+
+func TestRoundLovelaceErrors(t *testing.T) {
+	if _, err := fees.RoundLovelaceToNearest(1, 0); err == nil {
+		t.Error("RoundLovelaceToNearest: expected error for zero increment")
+	}
+	if _, err := fees.RoundLovelaceUpToNearest(1, 0); err == nil {
+		t.Error("RoundLovelaceUpToNearest: expected error for zero increment")
+	}
+	if _, err := fees.RoundLovelaceDownToNearest(1, 0); err == nil {
+		t.Error("RoundLovelaceDownToNearest: expected error for zero increment")
+	}
+	if _, err := fees.RoundLovelaceUpToNearest(math.MaxUint64, 1_000); err == nil {
+		t.Error("RoundLovelaceUpToNearest: expected overflow error")
+	}
+	if _, err := fees.RoundLovelaceToNearest(math.MaxUint64, 1_000); err == nil {
+		t.Error("RoundLovelaceToNearest: expected overflow error")
+	}
+}
@@ -0,0 +1,80 @@
+package fees
+
+// Metadata size estimators for common CIP metadata standards. Each returns
+// an approximate CBOR-serialized byte count suitable for the MetadataBytes
+// field of FeeEstimateOptions.
+//
+// All estimates include the top-level metadata map header (1 byte) and the
+// label as a CBOR unsigned integer (3 bytes for labels 256–65535, which
+// covers 674 and 721).
+const (
+	metadataMapHeaderBytes uint64 = 1
+	metadataLabelBytes     uint64 = 3
+)
+
+// MetadataCIP25Size estimates the serialized size of CIP-25 NFT metadata
+// (label 721) for numAssets assets under a single policy, each with
+// avgMetaBytesPerAsset bytes of serialized metadata (name, image, and so on).
+//
+// The model assumes:
+//   - the label's value map header:                 1 byte
+//   - the policy ID as a 56-character hex string:   58 bytes
+//   - the policy's asset map header:                3 bytes
+//   - each asset name as a text string key:         34 bytes (up to 32 bytes + header)
+//
+// Example:
+//
+//	n := fees.MetadataCIP25Size(1, 200) // one NFT with ~200 bytes of metadata
+func MetadataCIP25Size(numAssets uint64, avgMetaBytesPerAsset uint64) uint64 {
+	const (
+		labelMapHeader uint64 = 1
+		policyIDKey    uint64 = 58
+		assetMapHeader uint64 = 3
+		assetNameKey   uint64 = 34
+	)
+	base := metadataMapHeaderBytes + metadataLabelBytes + labelMapHeader + policyIDKey + assetMapHeader
+	return base + numAssets*(assetNameKey+avgMetaBytesPerAsset)
+}
+
+// MetadataCIP20Size estimates the serialized size of CIP-20 transaction
+// message metadata (label 674): {"msg": [line, line, ...]} with numMessages
+// lines averaging avgMsgBytes bytes each. CIP-20 limits each line to 64
+// bytes; longer messages must be split across lines.
+//
+// The model assumes:
+//   - the label's value map header:        1 byte
+//   - the "msg" text string key:           4 bytes
+//   - the message array header:            3 bytes
+//   - each line's text string header:      2 bytes
+//
+// Example:
+//
+//	n := fees.MetadataCIP20Size(2, 40) // two 40-byte lines
+func MetadataCIP20Size(numMessages uint64, avgMsgBytes uint64) uint64 {
+	const (
+		labelMapHeader   uint64 = 1
+		msgKey           uint64 = 4
+		arrayHeader      uint64 = 3
+		perMessageHeader uint64 = 2
+	)
+	base := metadataMapHeaderBytes + metadataLabelBytes + labelMapHeader + msgKey + arrayHeader
+	return base + numMessages*(perMessageHeader+avgMsgBytes)
+}
+
+// MetadataCIP67Size returns the extra bytes that CIP-67 asset name labels
+// add for numAssets tokens: a 4-byte label prefix on each asset name.
+//
+// CIP-67/CIP-68 tokens carry their metadata in the inline datum of a
+// reference token output, not in transaction metadata, so this is the only
+// per-transaction cost of the standard. Add the result to
+// OutputSize.TotalAssetNameBytes rather than to metadata bytes; size the
+// reference datum itself with OutputSize.InlineDatumBytes.
+//
+// Example:
+//
+//	// a CIP-68 NFT mints a (100) reference token and a (222) user token
+//	extra := fees.MetadataCIP67Size(2) // 8
+func MetadataCIP67Size(numAssets uint64) uint64 {
+	const labelPrefixBytes uint64 = 4
+	return numAssets * labelPrefixBytes
+}
@@ -0,0 +1,75 @@
+package fees_test
+
+import (
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestMetadataCIP25Size(t *testing.T) {
+	tests := []struct {
+		name      string
+		numAssets uint64
+		avgBytes  uint64
+		want      uint64
+	}{
+		{"no assets", 0, 0, 66},
+		{"one NFT", 1, 200, 66 + 234},
+		{"ten NFTs", 10, 150, 66 + 10*184},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fees.MetadataCIP25Size(tc.numAssets, tc.avgBytes); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMetadataCIP20Size(t *testing.T) {
+	tests := []struct {
+		name        string
+		numMessages uint64
+		avgBytes    uint64
+		want        uint64
+	}{
+		{"no messages", 0, 0, 12},
+		{"one line", 1, 64, 12 + 66},
+		{"three lines", 3, 40, 12 + 3*42},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fees.MetadataCIP20Size(tc.numMessages, tc.avgBytes); got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMetadataCIP67Size(t *testing.T) {
+	if got := fees.MetadataCIP67Size(2); got != 8 {
+		t.Errorf("got %d, want 8", got)
+	}
+	if got := fees.MetadataCIP67Size(0); got != 0 {
+		t.Errorf("got %d, want 0", got)
+	}
+}
+
+func TestMetadataSizeFeedsEstimate(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	size := fees.MetadataCIP25Size(1, 200)
+	fee, err := fees.EstimateFeeWithOptions(p, fees.FeeEstimateOptions{
+		NumInputs:     1,
+		NumOutputs:    2,
+		HasMetadata:   true,
+		MetadataBytes: size,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinFee(p, 200+140+2*65+size); fee != want {
+		t.Errorf("got %d, want %d", fee, want)
+	}
+}
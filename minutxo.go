@@ -1,6 +1,11 @@
 package fees
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+)
 
 // OutputSize describes a transaction output for minUTxO calculation purposes.
 // It models the byte-size contribution of the output's components.
@@ -46,6 +51,65 @@ type OutputSize struct {
 	ScriptRefBytes uint64
 }
 
+// Clone returns a deep copy of out. Prefer Clone over plain assignment when
+// deriving variants from a base OutputSize (for example, adding a datum hash
+// to compare costs): OutputSize currently holds only value fields, but
+// Clone will keep copies independent if reference fields are ever added.
+//
+// Example:
+//
+//	base := fees.OutputSize{AddressBytes: 57}
+//	withDatum := base.Clone()
+//	withDatum.HasDatumHash = true
+func (out OutputSize) Clone() OutputSize {
+	return out
+}
+
+// Validate checks out for missing or inconsistent fields, such as a flag
+// set without its byte count or a token bundle with assets but no
+// policies. All violations are reported together in a single
+// *ValidationError. MinUTxO does not call Validate, so that partially
+// described outputs can still be estimated.
+//
+// Example:
+//
+//	out := fees.OutputSize{AddressBytes: 57, HasInlineDatum: true}
+//	err := out.Validate() // InlineDatumBytes must be set
+func (out OutputSize) Validate() error {
+	var violations []string
+	if out.AddressBytes == 0 {
+		violations = append(violations, "AddressBytes must be non-zero")
+	}
+	if out.NumAssets > 0 && out.NumPolicies == 0 {
+		violations = append(violations, "NumAssets is set but NumPolicies is zero")
+	}
+	if out.NumPolicies > out.NumAssets {
+		violations = append(violations, "every policy must hold at least one asset")
+	}
+	if out.TotalAssetNameBytes > 32*out.NumAssets {
+		violations = append(violations, "TotalAssetNameBytes exceeds 32 bytes per asset")
+	}
+	if out.HasDatumHash && out.HasInlineDatum {
+		violations = append(violations, "HasDatumHash and HasInlineDatum are mutually exclusive")
+	}
+	if out.HasInlineDatum && out.InlineDatumBytes == 0 {
+		violations = append(violations, "InlineDatumBytes must be set when HasInlineDatum is true")
+	}
+	if out.InlineDatumBytes > 0 && !out.HasInlineDatum {
+		violations = append(violations, "InlineDatumBytes is set but HasInlineDatum is false")
+	}
+	if out.HasScriptRef && out.ScriptRefBytes == 0 {
+		violations = append(violations, "ScriptRefBytes must be set when HasScriptRef is true")
+	}
+	if out.ScriptRefBytes > 0 && !out.HasScriptRef {
+		violations = append(violations, "ScriptRefBytes is set but HasScriptRef is false")
+	}
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
 // MinUTxO calculates the minimum ADA (in Lovelace) that must be included
 // in a transaction output for the Babbage/Conway era using CIP-55's formula:
 //
@@ -70,13 +134,103 @@ type OutputSize struct {
 //	})
 //	// minADA ≈ 1,310,000 Lovelace (≈ 1.31 ADA)
 func MinUTxO(p ProtocolParams, out OutputSize) (uint64, error) {
-	if err := p.Validate(); err != nil {
+	if err := validateMinUTxOParams(p); err != nil {
 		return 0, err
 	}
 	serialized := EstimateOutputBytes(out)
 	return MinUTxOFromBytes(p, serialized)
 }
 
+// MinUTxOWithMargin returns the minUTxO of out raised by marginBPS basis
+// points, rounding up, as a buffer against estimation error:
+//
+//	ceil(minUTxO * (10000 + marginBPS) / 10000)
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOWithMargin(p, fees.OutputSize{AddressBytes: 57}, 100) // +1%
+func MinUTxOWithMargin(p ProtocolParams, out OutputSize, marginBPS uint64) (uint64, error) {
+	minADA, err := MinUTxO(p, out)
+	if err != nil {
+		return 0, err
+	}
+	factor, carry := bits.Add64(10_000, marginBPS, 0)
+	hi, lo := bits.Mul64(minADA, factor)
+	if carry != 0 || hi >= 10_000 {
+		return 0, NewMinUTxOError(fmt.Sprintf("margin of %d BPS overflows uint64", marginBPS))
+	}
+	quo, rem := bits.Div64(hi, lo, 10_000)
+	if rem != 0 {
+		if quo == math.MaxUint64 {
+			return 0, NewMinUTxOError(fmt.Sprintf("margin of %d BPS overflows uint64", marginBPS))
+		}
+		quo++
+	}
+	return quo, nil
+}
+
+// MinUTxOWithAbsoluteMargin returns the minUTxO of out plus a fixed buffer
+// of marginLovelace.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOWithAbsoluteMargin(p, fees.OutputSize{AddressBytes: 57}, 50_000)
+func MinUTxOWithAbsoluteMargin(p ProtocolParams, out OutputSize, marginLovelace uint64) (uint64, error) {
+	minADA, err := MinUTxO(p, out)
+	if err != nil {
+		return 0, err
+	}
+	return AddLovelace(minADA, marginLovelace)
+}
+
+// MinUTxOForFullOutput is a flat-parameter alternative to
+// MinUTxO(p, OutputSize{...}) for callers that prefer positional
+// arguments. A non-zero inlineDatumBytes or scriptRefBytes sets the
+// corresponding HasInlineDatum or HasScriptRef flag.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOForFullOutput(p, 57, 1, 1, 9, 0, 0, true)
+func MinUTxOForFullOutput(p ProtocolParams, addressBytes, numPolicies, numAssets, totalAssetNameBytes, inlineDatumBytes, scriptRefBytes uint64, hasDatumHash bool) (uint64, error) {
+	return MinUTxO(p, fullOutputSize(addressBytes, numPolicies, numAssets, totalAssetNameBytes, inlineDatumBytes, scriptRefBytes, hasDatumHash))
+}
+
+// MinUTxOForFullOutputValidated is MinUTxOForFullOutput, but it first
+// checks the constructed OutputSize with OutputSize.Validate and returns
+// its *ValidationError for inconsistent inputs.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	_, err := fees.MinUTxOForFullOutputValidated(p, 57, 0, 3, 0, 0, 0, false)
+//	// err: NumAssets is set but NumPolicies is zero
+func MinUTxOForFullOutputValidated(p ProtocolParams, addressBytes, numPolicies, numAssets, totalAssetNameBytes, inlineDatumBytes, scriptRefBytes uint64, hasDatumHash bool) (uint64, error) {
+	out := fullOutputSize(addressBytes, numPolicies, numAssets, totalAssetNameBytes, inlineDatumBytes, scriptRefBytes, hasDatumHash)
+	if err := out.Validate(); err != nil {
+		return 0, err
+	}
+	return MinUTxO(p, out)
+}
+
+// fullOutputSize builds the OutputSize for the MinUTxOForFullOutput
+// variants.
+func fullOutputSize(addressBytes, numPolicies, numAssets, totalAssetNameBytes, inlineDatumBytes, scriptRefBytes uint64, hasDatumHash bool) OutputSize {
+	return OutputSize{
+		AddressBytes:        addressBytes,
+		NumPolicies:         numPolicies,
+		NumAssets:           numAssets,
+		TotalAssetNameBytes: totalAssetNameBytes,
+		HasDatumHash:        hasDatumHash,
+		HasInlineDatum:      inlineDatumBytes > 0,
+		InlineDatumBytes:    inlineDatumBytes,
+		HasScriptRef:        scriptRefBytes > 0,
+		ScriptRefBytes:      scriptRefBytes,
+	}
+}
+
 // MinUTxOFromBytes calculates the minimum ADA in Lovelace using the
 // exact serialized byte count of the TxOut. Use this when you have
 // already CBOR-serialized the output and measured its length.
@@ -91,18 +245,99 @@ func MinUTxO(p ProtocolParams, out OutputSize) (uint64, error) {
 //	minADA, err := fees.MinUTxOFromBytes(p, 125)
 //	// minADA = (160 + 125) * 4310 = 1,228,350
 func MinUTxOFromBytes(p ProtocolParams, serializedOutputBytes uint64) (uint64, error) {
-	if err := p.Validate(); err != nil {
+	if err := validateMinUTxOParams(p); err != nil {
 		return 0, err
 	}
 	if serializedOutputBytes == 0 {
-		return 0, &MinUTxOError{Reason: "serializedOutputBytes must be greater than zero"}
+		return 0, newMinUTxOError(ErrCodeZeroBytes, ErrZeroBytes, "serializedOutputBytes must be greater than zero")
 	}
-	return (160 + serializedOutputBytes) * p.CoinsPerUTxOByte, nil
+	return (utxoEntryOverheadBytes + serializedOutputBytes) * p.CoinsPerUTxOByte, nil
+}
+
+// utxoEntryOverheadBytes is CIP-55's constant overhead: the bytes a UTxO
+// entry occupies in the ledger beyond its serialized TxOut (the TxIn key
+// and map bookkeeping).
+const utxoEntryOverheadBytes uint64 = 160
+
+// UTxOEntryOverheadBytes returns the constant per-entry overhead from
+// CIP-55 that is added to the serialized output size: 160 bytes.
+//
+// Example:
+//
+//	fees.UTxOEntryOverheadBytes() // 160
+func UTxOEntryOverheadBytes() uint64 {
+	return utxoEntryOverheadBytes
+}
+
+// TotalUTxOBytes returns the number of bytes the ledger charges a UTxO
+// entry for: 160 + serializedOutputBytes.
+//
+// Example:
+//
+//	fees.TotalUTxOBytes(125) // 285
+func TotalUTxOBytes(serializedOutputBytes uint64) uint64 {
+	return utxoEntryOverheadBytes + serializedOutputBytes
+}
+
+// MinUTxOFromComponents computes the same value as MinUTxOFromBytes, but
+// spells out each step of the CIP-55 formula with the spec's names. It is
+// intended for verification and as a readable reference.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOFromComponents(p, 125)
+//	// (160 + 125) * 4310 = 1,228,350
+func MinUTxOFromComponents(p ProtocolParams, serializedOutputBytes uint64) (uint64, error) {
+	if err := validateMinUTxOParams(p); err != nil {
+		return 0, err
+	}
+	if serializedOutputBytes == 0 {
+		return 0, newMinUTxOError(ErrCodeZeroBytes, ErrZeroBytes, "serializedOutputBytes must be greater than zero")
+	}
+
+	// CIP-55: minUTxOValue = (constantOverhead + |serialize(txout)|) * coinsPerUTxOByte
+	constantOverhead := UTxOEntryOverheadBytes()
+	serializedTxOutSize := serializedOutputBytes
+	coinsPerUTxOByte := p.CoinsPerUTxOByte
+
+	utxoEntrySize := constantOverhead + serializedTxOutSize
+	minUTxOValue := utxoEntrySize * coinsPerUTxOByte
+	return minUTxOValue, nil
 }
 
 // EstimateOutputBytes estimates the serialized CBOR byte size of a TxOut
-// from its structural components. This is based on the Mary/Babbage era
-// ledger size model used in the minUTxO specification.
+// from its structural components. It is an alias for
+// EstimateOutputBytesPostAlonzo, since every output built today uses the
+// post-Alonzo map format; use EstimateOutputBytesLegacy for the pre-Alonzo
+// array format.
+//
+// Example:
+//
+//	size := fees.EstimateOutputBytes(fees.OutputSize{
+//		AddressBytes:        57,
+//		NumPolicies:         1,
+//		NumAssets:           1,
+//		TotalAssetNameBytes: 9,
+//	})
+func EstimateOutputBytes(out OutputSize) uint64 {
+	return EstimateOutputBytesPostAlonzo(out)
+}
+
+// postAlonzoEnvelopeBytes is the TxOut envelope overhead in the map
+// format: the map header, the integer key of each field, and the byte
+// string headers.
+const postAlonzoEnvelopeBytes uint64 = 10
+
+// legacyEnvelopeBytes is the TxOut envelope overhead in the pre-Alonzo
+// array format, which has no per-field keys: postAlonzoEnvelopeBytes less
+// the address and value keys.
+const legacyEnvelopeBytes uint64 = postAlonzoEnvelopeBytes - 2
+
+// EstimateOutputBytesPostAlonzo estimates the serialized CBOR byte size of
+// a TxOut in the post-Alonzo (Babbage/Conway) map format, {0: address,
+// 1: value, 2: datum, 3: script_ref}. This is based on the Mary/Babbage
+// era ledger size model used in the minUTxO specification.
 //
 // The estimate uses the following model:
 //   - TxOut envelope overhead:    ~10 bytes
@@ -119,25 +354,63 @@ func MinUTxOFromBytes(p ProtocolParams, serializedOutputBytes uint64) (uint64, e
 //
 // Example:
 //
-//	size := fees.EstimateOutputBytes(fees.OutputSize{
-//		AddressBytes:        57,
+//	size := fees.EstimateOutputBytesPostAlonzo(fees.OutputSize{
+//		AddressBytes:     57,
+//		HasInlineDatum:   true,
+//		InlineDatumBytes: 120,
+//	})
+func EstimateOutputBytesPostAlonzo(out OutputSize) uint64 {
+	total := estimateOutputBytes(out, postAlonzoEnvelopeBytes)
+	if out.HasInlineDatum {
+		total += out.InlineDatumBytes
+	}
+	if out.HasScriptRef {
+		total += out.ScriptRefBytes
+	}
+	return total
+}
+
+// EstimateOutputBytesLegacy estimates the serialized CBOR byte size of a
+// TxOut in the pre-Alonzo array format, [address, value] or [address,
+// value, datum_hash]. The array format has no field keys, so it is 2 bytes
+// smaller than the map format for the same output. It cannot carry an
+// inline datum or a reference script, so HasInlineDatum and HasScriptRef
+// are ignored.
+//
+// Example:
+//
+//	fees.EstimateOutputBytesLegacy(fees.OutputSize{AddressBytes: 57}) // 74
+func EstimateOutputBytesLegacy(out OutputSize) uint64 {
+	return estimateOutputBytes(out, legacyEnvelopeBytes)
+}
+
+// TokenBundleValueBytes estimates the serialized size of just the value
+// field of out, the ADA amount plus any native tokens, which is what the
+// ledger checks against MaxValueSize:
+//
+//	9 (ADA)
+//	+ 5 + 28*NumPolicies + 17*NumAssets + TotalAssetNameBytes (if tokens)
+//
+// It is the value portion of EstimateOutputBytes.
+//
+// Example:
+//
+//	n := fees.TokenBundleValueBytes(fees.OutputSize{
 //		NumPolicies:         1,
 //		NumAssets:           1,
-//		TotalAssetNameBytes: 9,
+//		TotalAssetNameBytes: 8,
 //	})
-func EstimateOutputBytes(out OutputSize) uint64 {
+//	// 9 + 5 + 28 + 17 + 8 = 67
+func TokenBundleValueBytes(out OutputSize) uint64 {
 	const (
-		envelopeOverhead  uint64 = 10
 		adaValueBytes     uint64 = 9
-		policyHashBytes   uint64 = 28
+		policyHashBytes   uint64 = policyIDBytes
 		perAssetOverhead  uint64 = 12
 		perAssetIntBytes  uint64 = 5
 		tokenBundleFixed  uint64 = 5
-		datumHashBytes    uint64 = 32
 	)
 
-	total := envelopeOverhead + out.AddressBytes + adaValueBytes
-
+	total := adaValueBytes
 	if out.NumAssets > 0 || out.NumPolicies > 0 {
 		total += tokenBundleFixed
 		total += policyHashBytes * out.NumPolicies
@@ -145,19 +418,41 @@ func EstimateOutputBytes(out OutputSize) uint64 {
 		total += out.TotalAssetNameBytes
 		total += perAssetIntBytes * out.NumAssets
 	}
+	return total
+}
 
-	if out.HasDatumHash {
-		total += datumHashBytes
+// IsTokenBundleWithinMaxValueSize reports whether the value of out fits in
+// p.MaxValueSize, and returns the TokenBundleValueBytes estimate it
+// checked. Outputs whose value is too large are rejected by the ledger
+// regardless of how much ADA they hold.
+//
+// Returns a *MinUTxOError with ErrCodeInvalidParams, wrapping the
+// *ParamError, if p is invalid or p.MaxValueSize is zero.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	ok, size, err := fees.IsTokenBundleWithinMaxValueSize(p, out)
+func IsTokenBundleWithinMaxValueSize(p ProtocolParams, out OutputSize) (bool, uint64, error) {
+	if err := validateMinUTxOParams(p); err != nil {
+		return false, 0, err
 	}
-
-	if out.HasInlineDatum {
-		total += out.InlineDatumBytes
+	if p.MaxValueSize == 0 {
+		return false, 0, newMinUTxOError(ErrCodeInvalidParams, &ParamError{Field: "MaxValueSize", Message: "must be non-zero for the value size check"}, "MaxValueSize must be non-zero for the value size check")
 	}
+	size := TokenBundleValueBytes(out)
+	return size <= p.MaxValueSize, size, nil
+}
 
-	if out.HasScriptRef {
-		total += out.ScriptRefBytes
-	}
+// estimateOutputBytes returns the size of the parts of a TxOut common to
+// both formats: the envelope, address, value, and datum hash.
+func estimateOutputBytes(out OutputSize, envelopeOverhead uint64) uint64 {
+	const datumHashBytes uint64 = 32
 
+	total := envelopeOverhead + out.AddressBytes + TokenBundleValueBytes(out)
+	if out.HasDatumHash {
+		total += datumHashBytes
+	}
 	return total
 }
 
@@ -173,6 +468,34 @@ func MinUTxOADAOnly(p ProtocolParams) (uint64, error) {
 	return MinUTxO(p, OutputSize{AddressBytes: 57})
 }
 
+// IsMinUTxOAboveADAOnly reports whether out costs more than the same
+// output without its native tokens, i.e. whether it carries a multi-asset
+// premium. It also returns both minimums for comparison: outMin for out,
+// and adaOnlyMin for out with NumPolicies, NumAssets, and
+// TotalAssetNameBytes cleared.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	above, outMin, adaOnlyMin, err := fees.IsMinUTxOAboveADAOnly(p, fees.OutputSize{
+//		AddressBytes:        57,
+//		NumPolicies:         1,
+//		NumAssets:           1,
+//		TotalAssetNameBytes: 8,
+//	})
+//	// above = true
+func IsMinUTxOAboveADAOnly(p ProtocolParams, out OutputSize) (above bool, outMin, adaOnlyMin uint64, err error) {
+	if outMin, err = MinUTxO(p, out); err != nil {
+		return false, 0, 0, err
+	}
+	adaOnly := out
+	adaOnly.NumPolicies, adaOnly.NumAssets, adaOnly.TotalAssetNameBytes = 0, 0, 0
+	if adaOnlyMin, err = MinUTxO(p, adaOnly); err != nil {
+		return false, 0, 0, err
+	}
+	return outMin > adaOnlyMin, outMin, adaOnlyMin, nil
+}
+
 // MinUTxOForNFT returns the minimum Lovelace for a UTxO holding a single
 // NFT (one policy, one asset) with a standard Shelley base address.
 //
@@ -184,9 +507,7 @@ func MinUTxOADAOnly(p ProtocolParams) (uint64, error) {
 //	minADA, err := fees.MinUTxOForNFT(p, 32) // asset name is 32 bytes
 func MinUTxOForNFT(p ProtocolParams, assetNameLen uint64) (uint64, error) {
 	if assetNameLen > 32 {
-		return 0, &MinUTxOError{
-			Reason: fmt.Sprintf("assetNameLen %d exceeds maximum of 32 bytes", assetNameLen),
-		}
+		return 0, newMinUTxOError(ErrCodeAssetNameTooLong, ErrAssetNameTooLong, fmt.Sprintf("assetNameLen %d exceeds maximum of 32 bytes", assetNameLen))
 	}
 	return MinUTxO(p, OutputSize{
 		AddressBytes:        57,
@@ -206,10 +527,10 @@ func MinUTxOForNFT(p ProtocolParams, assetNameLen uint64) (uint64, error) {
 //	minADA, err := fees.MinUTxOForBundle(p, 2, 5, 80)
 func MinUTxOForBundle(p ProtocolParams, numPolicies, numAssets, totalAssetNameBytes uint64) (uint64, error) {
 	if numPolicies == 0 {
-		return 0, &MinUTxOError{Reason: "numPolicies must be at least 1"}
+		return 0, newMinUTxOError(ErrCodeEmptyBundle, ErrEmptyBundle, "numPolicies must be at least 1")
 	}
 	if numAssets == 0 {
-		return 0, &MinUTxOError{Reason: "numAssets must be at least 1"}
+		return 0, newMinUTxOError(ErrCodeEmptyBundle, ErrEmptyBundle, "numAssets must be at least 1")
 	}
 	return MinUTxO(p, OutputSize{
 		AddressBytes:        57,
@@ -219,12 +540,464 @@ func MinUTxOForBundle(p ProtocolParams, numPolicies, numAssets, totalAssetNameBy
 	})
 }
 
+// MinUTxOForNFTBundle returns the minimum Lovelace for an output to a
+// Shelley base address holding numNFTs NFTs, as marketplaces commonly list
+// several tokens in one UTxO. It assumes the worst case of one policy per
+// NFT, with total asset name bytes of numNFTs * avgAssetNameLen.
+//
+// Returns an error if numNFTs is zero or avgAssetNameLen exceeds 32.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOForNFTBundle(p, 5, 12)
+func MinUTxOForNFTBundle(p ProtocolParams, numNFTs uint64, avgAssetNameLen uint64) (uint64, error) {
+	if avgAssetNameLen > 32 {
+		return 0, newMinUTxOError(ErrCodeAssetNameTooLong, ErrAssetNameTooLong, fmt.Sprintf("avgAssetNameLen %d exceeds maximum of 32 bytes", avgAssetNameLen))
+	}
+	return MinUTxOForBundle(p, numNFTs, numNFTs, numNFTs*avgAssetNameLen)
+}
+
+// IsMinUTxOStable reports whether the structural estimate MinUTxO(p, out)
+// agrees with the exact MinUTxOFromBytes(p, actualCBORBytes) to within
+// toleranceBPS basis points (1 bps = 0.01%) of the exact value.
+//
+// This is a diagnostic for validating how well EstimateOutputBytes models
+// a particular kind of output: serialize a representative output, measure
+// it, and check the estimate against it.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	ok, err := fees.IsMinUTxOStable(p, fees.OutputSize{AddressBytes: 57}, 67, 100) // within 1%
+func IsMinUTxOStable(p ProtocolParams, out OutputSize, actualCBORBytes uint64, toleranceBPS uint64) (bool, error) {
+	estimated, err := MinUTxO(p, out)
+	if err != nil {
+		return false, err
+	}
+	exact, err := MinUTxOFromBytes(p, actualCBORBytes)
+	if err != nil {
+		return false, err
+	}
+
+	var delta uint64
+	if estimated > exact {
+		delta = estimated - exact
+	} else {
+		delta = exact - estimated
+	}
+	// delta/exact <= toleranceBPS/10000, rearranged to avoid division and
+	// compared in 128 bits so a large toleranceBPS cannot wrap.
+	deltaHi, deltaLo := bits.Mul64(delta, 10_000)
+	limitHi, limitLo := bits.Mul64(exact, toleranceBPS)
+	return deltaHi < limitHi || deltaHi == limitHi && deltaLo <= limitLo, nil
+}
+
+// IsEstimateConservative reports whether EstimateOutputBytes(out) is at
+// least actualCBORBytes, the measured size of the serialized output, and
+// returns both sizes. The estimator is meant to err on the high side, so a
+// false result means the byte model under-estimates this kind of output and
+// needs updating.
+//
+// Returns a *MinUTxOError with ErrCodeInvalidParams if p is invalid, and
+// an error if out is invalid or actualCBORBytes is zero.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	ok, est, actual, err := fees.IsEstimateConservative(p, fees.OutputSize{AddressBytes: 57}, 67)
+//	// ok = true, est = 76, actual = 67
+func IsEstimateConservative(p ProtocolParams, out OutputSize, actualCBORBytes uint64) (ok bool, estimate, actual uint64, err error) {
+	if err := validateMinUTxOParams(p); err != nil {
+		return false, 0, 0, err
+	}
+	if err := out.Validate(); err != nil {
+		return false, 0, 0, err
+	}
+	if actualCBORBytes == 0 {
+		return false, 0, 0, newMinUTxOError(ErrCodeZeroBytes, ErrZeroBytes, "actualCBORBytes must be non-zero")
+	}
+	estimate = EstimateOutputBytes(out)
+	return estimate >= actualCBORBytes, estimate, actualCBORBytes, nil
+}
+
+// CostPerAdditionalAsset returns the extra minUTxO, in Lovelace, required
+// when one more asset (under an existing policy) is added to an output.
+// It is computed as the difference between a one-policy bundle holding two
+// assets and the same bundle holding one asset, each named with
+// assetNameLen bytes.
+//
+// assetNameLen is the byte length of the asset name (0–32).
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.CostPerAdditionalAsset(p, 32)
+//	fmt.Println("each additional token costs", fees.FormatADA(cost))
+func CostPerAdditionalAsset(p ProtocolParams, assetNameLen uint64) (uint64, error) {
+	if assetNameLen > 32 {
+		return 0, newMinUTxOError(ErrCodeAssetNameTooLong, ErrAssetNameTooLong, fmt.Sprintf("assetNameLen %d exceeds maximum of 32 bytes", assetNameLen))
+	}
+	one, err := MinUTxOForBundle(p, 1, 1, assetNameLen)
+	if err != nil {
+		return 0, err
+	}
+	two, err := MinUTxOForBundle(p, 1, 2, assetNameLen*2)
+	if err != nil {
+		return 0, err
+	}
+	return two - one, nil
+}
+
+// CostPerAdditionalPolicy returns the extra minUTxO, in Lovelace, required
+// when the assets of an output are spread over one more policy. The asset
+// count and names are held constant, so the result is the cost of the
+// additional 28-byte policy ID and its map entry alone.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	cost, err := fees.CostPerAdditionalPolicy(p)
+func CostPerAdditionalPolicy(p ProtocolParams) (uint64, error) {
+	one, err := MinUTxOForBundle(p, 1, 2, 0)
+	if err != nil {
+		return 0, err
+	}
+	two, err := MinUTxOForBundle(p, 2, 2, 0)
+	if err != nil {
+		return 0, err
+	}
+	return two - one, nil
+}
+
+// MinUTxODeltaForAsset returns the extra minUTxO, in Lovelace, of adding
+// one asset named with newAssetNameBytes bytes to existingOut, for showing
+// users "adding this token requires X more ADA":
+//
+//	MinUTxO(extended) - MinUTxO(existingOut)
+//
+// where extended holds one more asset and, if newPolicy is true, one more
+// policy. When newPolicy is false the asset joins a policy already in the
+// output and no policy bytes are added, so existingOut must hold at least
+// one policy. Adding the first token to an ADA-only output also adds the
+// token bundle's fixed overhead. The delta is never negative.
+//
+// Returns a *MinUTxOError if newAssetNameBytes exceeds 32, or if newPolicy
+// is false and existingOut has no policies.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	delta, err := fees.MinUTxODeltaForAsset(p, fees.OutputSize{AddressBytes: 57}, 8, true)
+func MinUTxODeltaForAsset(p ProtocolParams, existingOut OutputSize, newAssetNameBytes uint64, newPolicy bool) (uint64, error) {
+	if !IsValidAssetNameLength(newAssetNameBytes) {
+		return 0, newMinUTxOError(ErrCodeAssetNameTooLong, ErrAssetNameTooLong, fmt.Sprintf("newAssetNameBytes %d exceeds maximum of 32 bytes", newAssetNameBytes))
+	}
+	if !newPolicy && existingOut.NumPolicies == 0 {
+		return 0, newMinUTxOError(ErrCodeEmptyBundle, ErrEmptyBundle, "newPolicy must be true when existingOut has no policies")
+	}
+	before, err := MinUTxO(p, existingOut)
+	if err != nil {
+		return 0, err
+	}
+	extended := existingOut
+	extended.NumAssets++
+	extended.TotalAssetNameBytes += newAssetNameBytes
+	if newPolicy {
+		extended.NumPolicies++
+	}
+	after, err := MinUTxO(p, extended)
+	if err != nil {
+		return 0, err
+	}
+	return after - before, nil
+}
+
+// ByronAddressBytes is a conservative upper bound on the byte length of a
+// legacy Byron (bootstrap) address.
+//
+// Byron addresses are CBOR-wrapped structures containing a 28-byte root hash,
+// an attributes map (which may carry an encrypted HD derivation path and, on
+// testnets, the network magic), and a CRC32 checksum. They range from 43
+// bytes (Icarus-style, mainnet) to 83 bytes (Daedalus-style, testnet); see
+// ByronAddressByteSize for exact sizes.
+const ByronAddressBytes uint64 = 83
+
+// ByronAddressVariant identifies the kind of a Byron address, which
+// together with the network determines its size.
+type ByronAddressVariant uint8
+
+const (
+	// ByronPubKey is a public-key address without a derivation path, as
+	// created by Icarus-style (Yoroi) wallets. 43 bytes on mainnet.
+	ByronPubKey ByronAddressVariant = iota
+
+	// ByronScript is a script address. 43 bytes on mainnet.
+	ByronScript
+
+	// ByronRedeem is an AVVM redemption address from the ADA voucher sale.
+	// 43 bytes on mainnet.
+	ByronRedeem
+
+	// ByronPubKeyHD is a public-key address carrying an encrypted HD
+	// derivation path, as created by Daedalus Byron-era wallets. 76 bytes
+	// on mainnet.
+	ByronPubKeyHD
+)
+
+// String returns the variant's name, e.g. "pubkey".
+func (v ByronAddressVariant) String() string {
+	switch v {
+	case ByronPubKey:
+		return "pubkey"
+	case ByronScript:
+		return "script"
+	case ByronRedeem:
+		return "redeem"
+	case ByronPubKeyHD:
+		return "pubkey-hd"
+	default:
+		return fmt.Sprintf("ByronAddressVariant(%d)", uint8(v))
+	}
+}
+
+// ByronAddressByteSize returns the serialized byte length of a Byron
+// address of variant v on the network identified by networkMagic. Mainnet
+// addresses (networkMagic 0 or MainnetNetworkMagic) carry no network
+// attribute; testnet addresses embed the magic, adding 3–7 bytes.
+//
+//	envelope (array, tag 24, CRC32):  10 bytes
+//	payload array + root hash:        31 bytes
+//	attributes map:                    1 byte
+//	derivation path (ByronPubKeyHD):  33 bytes
+//	network magic (testnets):         2 bytes + CBOR uint size
+//	address type:                      1 byte
+//
+// Unknown variants return the ByronAddressBytes upper bound.
+//
+// Example:
+//
+//	fees.ByronAddressByteSize(fees.ByronPubKey, fees.MainnetNetworkMagic) // 43
+//	fees.ByronAddressByteSize(fees.ByronPubKeyHD, 1097911063)             // 83
+func ByronAddressByteSize(v ByronAddressVariant, networkMagic uint32) uint64 {
+	const (
+		envelopeBytes       uint64 = 10
+		payloadRootBytes    uint64 = 31
+		attributesMapBytes  uint64 = 1
+		derivationPathBytes uint64 = 33
+		magicAttrBytes      uint64 = 2 // map key + byte string header
+		addrTypeBytes       uint64 = 1
+	)
+	size := envelopeBytes + payloadRootBytes + attributesMapBytes + addrTypeBytes
+	switch v {
+	case ByronPubKey, ByronScript, ByronRedeem:
+	case ByronPubKeyHD:
+		size += derivationPathBytes
+	default:
+		return ByronAddressBytes
+	}
+	if networkMagic != 0 && networkMagic != MainnetNetworkMagic {
+		size += magicAttrBytes + cborUintBytes(uint64(networkMagic))
+	}
+	return size
+}
+
+// cborUintBytes returns the encoded size of n as a CBOR unsigned integer.
+func cborUintBytes(n uint64) uint64 {
+	switch {
+	case n < 24:
+		return 1
+	case n <= 0xff:
+		return 2
+	case n <= 0xffff:
+		return 3
+	case n <= 0xffffffff:
+		return 5
+	default:
+		return 9
+	}
+}
+
+// MinUTxOForByronAddress returns the minimum Lovelace for an ADA-only output
+// sent to a legacy Byron address of variant v on p's network, sized with
+// ByronAddressByteSize(v, p.NetworkMagic).
+//
+// Byron addresses are rarely used in new transactions, but they still appear
+// when consolidating old UTxOs from legacy (Daedalus/Yoroi Byron-era) wallets.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOForByronAddress(p, fees.ByronPubKeyHD)
+func MinUTxOForByronAddress(p ProtocolParams, v ByronAddressVariant) (uint64, error) {
+	if v > ByronPubKeyHD {
+		return 0, newMinUTxOError(ErrCodeUnknownAddressType, ErrUnknownAddressType, fmt.Sprintf("unknown Byron address variant %d", uint8(v)))
+	}
+	return MinUTxO(p, OutputSize{AddressBytes: ByronAddressByteSize(v, p.NetworkMagic)})
+}
+
+// MaxMinUTxOBound returns a conservative amount of Lovelace to reserve for
+// an output whose structure is not yet known. It is the minUTxO of a large
+// output whose value, serialized, fits in p.MaxValueSize:
+//
+//	address:      Shelley base address (57 bytes)
+//	policies:     1
+//	assets:       (MaxValueSize - 46) / 43
+//	asset names:  32 bytes each (the maximum)
+//	inline datum: 1,024 bytes
+//
+// Each asset serializes to 43 bytes (a 2-byte name header, the 32-byte
+// name, and a quantity of up to 9 bytes), and the coin, map headers, and
+// policy ID take at most 46 more.
+//
+// This is a practical reserve, not the theoretical maximum for any possible
+// output: a larger datum or a reference script can still exceed it.
+//
+// Returns a *MinUTxOError with ErrCodeInvalidParams, wrapping a
+// *ParamError, if p is invalid or p.MaxValueSize is zero.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	reserve, err := fees.MaxMinUTxOBound(p) // 115 assets on mainnet
+func MaxMinUTxOBound(p ProtocolParams) (uint64, error) {
+	const (
+		// boundValueFixedBytes is the value array header, a 9-byte coin,
+		// the outer and inner map headers (up to 3 bytes each), and a
+		// policy ID with its 2-byte header.
+		boundValueFixedBytes uint64 = 1 + 9 + 3 + 30 + 3
+		bytesPerBoundAsset   uint64 = 2 + 32 + 9
+		boundDatumBytes      uint64 = 1024
+	)
+	if err := validateMinUTxOParams(p); err != nil {
+		return 0, err
+	}
+	if p.MaxValueSize == 0 {
+		return 0, newMinUTxOError(ErrCodeInvalidParams, &ParamError{Field: "MaxValueSize", Message: "must be non-zero for the minUTxO bound"}, "MaxValueSize must be non-zero for the minUTxO bound")
+	}
+	var numAssets uint64
+	if p.MaxValueSize > boundValueFixedBytes {
+		numAssets = (p.MaxValueSize - boundValueFixedBytes) / bytesPerBoundAsset
+	}
+	out := OutputSize{
+		AddressBytes:        57,
+		NumPolicies:         1,
+		NumAssets:           numAssets,
+		TotalAssetNameBytes: 32 * numAssets,
+		HasInlineDatum:      true,
+		InlineDatumBytes:    boundDatumBytes,
+	}
+	if numAssets == 0 {
+		out.NumPolicies = 0
+	}
+	return MinUTxO(p, out)
+}
+
+// ErrorCode classifies a *MinUTxOError for programmatic handling, so that
+// callers can switch on the failure mode instead of matching strings.
+type ErrorCode int
+
+// Error codes carried by MinUTxOError.Code.
+const (
+	// ErrCodeZeroBytes: a serialized size of zero bytes was given.
+	ErrCodeZeroBytes ErrorCode = 1
+
+	// ErrCodeInvalidParams: the protocol parameters cannot be used for a
+	// minUTxO calculation. The Cause is the *ParamError, so errors.As and
+	// the ErrInvalid* sentinels still match.
+	ErrCodeInvalidParams ErrorCode = 2
+
+	// ErrCodeAssetNameTooLong: an asset name exceeds 32 bytes.
+	ErrCodeAssetNameTooLong ErrorCode = 3
+
+	// ErrCodeEmptyBundle: a token bundle helper was given zero policies
+	// or zero assets.
+	ErrCodeEmptyBundle ErrorCode = 4
+
+	// ErrCodeBelowMinUTxO: an output's Lovelace is below its minUTxO.
+	ErrCodeBelowMinUTxO ErrorCode = 5
+
+	// ErrCodeUnknownAddressType: an AddressType value is not recognised.
+	ErrCodeUnknownAddressType ErrorCode = 6
+
+	// ErrCodeAboveMaxSupply: an output's Lovelace exceeds MaxSupplyLovelace.
+	ErrCodeAboveMaxSupply ErrorCode = 7
+)
+
+// Sentinel causes carried by MinUTxOError.Cause, for matching with
+// errors.Is:
+//
+//	if errors.Is(err, fees.ErrBelowMinUTxO) { ... }
+var (
+	ErrZeroBytes          = errors.New("fees: serialized size is zero")
+	ErrAssetNameTooLong   = errors.New("fees: asset name exceeds 32 bytes")
+	ErrEmptyBundle        = errors.New("fees: token bundle has no assets")
+	ErrBelowMinUTxO       = errors.New("fees: output is below minUTxO")
+	ErrUnknownAddressType = errors.New("fees: unknown address type")
+	ErrAboveMaxSupply     = errors.New("fees: output exceeds maximum ADA supply")
+)
+
 // MinUTxOError is returned when a minUTxO calculation cannot be completed.
 type MinUTxOError struct {
+	// Code classifies the failure. Zero means unclassified.
+	Code ErrorCode
+
 	// Reason describes why the calculation failed.
 	Reason string
+
+	// Cause is the sentinel error for the failure, such as ErrZeroBytes,
+	// or nil if there is none. It is returned by Unwrap.
+	Cause error
+}
+
+// NewMinUTxOError returns an unclassified *MinUTxOError with the given
+// reason. It panics if reason is empty, so that every MinUTxOError explains
+// itself.
+//
+// Example:
+//
+//	return 0, fees.NewMinUTxOError("output has no address")
+func NewMinUTxOError(reason string) *MinUTxOError {
+	if reason == "" {
+		panic("fees: NewMinUTxOError: reason must not be empty")
+	}
+	return &MinUTxOError{Reason: reason}
+}
+
+// validateMinUTxOParams is p.Validate for the minUTxO functions: a
+// failure is returned as a *MinUTxOError with ErrCodeInvalidParams whose
+// Cause is the *ParamError.
+func validateMinUTxOParams(p ProtocolParams) error {
+	err := p.Validate()
+	var pe *ParamError
+	if errors.As(err, &pe) {
+		return newMinUTxOError(ErrCodeInvalidParams, pe, "invalid protocol param "+pe.Field+": "+pe.Message)
+	}
+	return err
+}
+
+// newMinUTxOError is NewMinUTxOError with a Code and its sentinel Cause.
+func newMinUTxOError(code ErrorCode, cause error, reason string) *MinUTxOError {
+	e := NewMinUTxOError(reason)
+	e.Code = code
+	e.Cause = cause
+	return e
 }
 
 func (e *MinUTxOError) Error() string {
 	return "fees: minUTxO: " + e.Reason
-}
\ No newline at end of file
+}
+
+// Is reports whether target is a *MinUTxOError with the same non-zero Code,
+// so that errors.Is(err, &fees.MinUTxOError{Code: fees.ErrCodeZeroBytes})
+// matches regardless of the reason text.
+func (e *MinUTxOError) Is(target error) bool {
+	t, ok := target.(*MinUTxOError)
+	return ok && t.Code != 0 && t.Code == e.Code
+}
+
+// Unwrap returns e.Cause, so that errors.Is(err, fees.ErrZeroBytes) matches
+// a *MinUTxOError caused by a zero serialized size.
+func (e *MinUTxOError) Unwrap() error {
+	return e.Cause
+}
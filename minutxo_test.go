@@ -1,6 +1,9 @@
 package fees_test
 
 import (
+	"encoding/hex"
+	"errors"
+	"math"
 	"testing"
 
 	fees "github.com/njchilds90/go-cardano-fees"
@@ -146,7 +149,7 @@ func TestMinUTxOBundleGrowth(t *testing.T) {
 		t.Errorf("expected min1 < min5, got %d >= %d", min1, min5)
 	}
 	if min5 >= min10 {
-		tt.Errorf("expected min5 < min10, got %d >= %d", min5, min10)
+		t.Errorf("expected min5 < min10, got %d >= %d", min5, min10)
 	}
 }
 
@@ -186,4 +189,656 @@ func TestEstimateOutputBytes(t *testing.T) {
 	if adaOnly >= nft {
 		t.Errorf("ADA-only (%d bytes) should be smaller than NFT (%d bytes)", adaOnly, nft)
 	}
-}
\ No newline at end of file
+}
+
+func TestMinUTxOForByronAddress(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	got, err := fees.MinUTxOForByronAddress(p, fees.ByronPubKeyHD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := fees.MinUTxO(p, fees.OutputSize{AddressBytes: 76})
+	if got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+	// A Daedalus-style Byron address is larger than a Shelley base address.
+	adaOnly, _ := fees.MinUTxOADAOnly(p)
+	if got <= adaOnly {
+		t.Errorf("Byron minUTxO %d should exceed Shelley ADA-only %d", got, adaOnly)
+	}
+
+	// On a testnet the address carries the network magic.
+	preprod, _ := fees.MinUTxOForByronAddress(fees.DefaultPreProdParams(), fees.ByronPubKeyHD)
+	if want, _ := fees.MinUTxO(p, fees.OutputSize{AddressBytes: 79}); preprod != want {
+		t.Errorf("preprod got %d, want %d", preprod, want)
+	}
+
+	if _, err := fees.MinUTxOForByronAddress(fees.ProtocolParams{}, fees.ByronPubKey); err == nil {
+		t.Error("expected error for zero params")
+	}
+	_, err = fees.MinUTxOForByronAddress(p, fees.ByronAddressVariant(9))
+	if !errors.Is(err, fees.ErrUnknownAddressType) {
+		t.Errorf("err = %v, want ErrUnknownAddressType", err)
+	}
+}
+
+func TestByronAddressByteSize(t *testing.T) {
+	const legacyTestnetMagic = 1097911063
+
+	tests := []struct {
+		v     fees.ByronAddressVariant
+		magic uint32
+		want  uint64
+	}{
+		{fees.ByronPubKey, fees.MainnetNetworkMagic, 43},
+		{fees.ByronPubKey, 0, 43},
+		{fees.ByronScript, fees.MainnetNetworkMagic, 43},
+		{fees.ByronRedeem, fees.MainnetNetworkMagic, 43},
+		{fees.ByronPubKeyHD, fees.MainnetNetworkMagic, 76},
+		{fees.ByronPubKey, fees.PreProdNetworkMagic, 46},
+		{fees.ByronPubKey, legacyTestnetMagic, 50},
+		{fees.ByronPubKeyHD, legacyTestnetMagic, fees.ByronAddressBytes},
+		{fees.ByronAddressVariant(9), 0, fees.ByronAddressBytes},
+	}
+
+	for _, tc := range tests {
+		if got := fees.ByronAddressByteSize(tc.v, tc.magic); got != tc.want {
+			t.Errorf("ByronAddressByteSize(%v, %d) = %d, want %d", tc.v, tc.magic, got, tc.want)
+		}
+	}
+}
+
+func TestIsMinUTxOStable(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+	estimatedBytes := fees.EstimateOutputBytes(out) // 76
+
+	tests := []struct {
+		name         string
+		actualBytes  uint64
+		toleranceBPS uint64
+		want         bool
+		wantErr      bool
+	}{
+		{"exact match zero tolerance", estimatedBytes, 0, true, false},
+		{"off by one within 1%", estimatedBytes + 1, 100, true, false},
+		{"off by one zero tolerance", estimatedBytes + 1, 0, false, false},
+		{"far off beyond 1%", estimatedBytes + 50, 100, false, false},
+		{"far off within 50%", estimatedBytes + 50, 5_000, true, false},
+		{"tolerance product beyond uint64", estimatedBytes + 50, 1 << 63, true, false},
+		{"max tolerance", estimatedBytes + 50, math.MaxUint64, true, false},
+		{"zero actual bytes", 0, 100, false, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.IsMinUTxOStable(p, out, tc.actualBytes, tc.toleranceBPS)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsEstimateConservative(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	// Reference post-Alonzo (map-format) TxOut encodings per the Babbage
+	// CDDL, each paying 2 ADA (a 4-byte coin) with placeholder address
+	// bytes, hashes, and policy IDs of the real lengths.
+	tests := []struct {
+		name    string
+		out     fees.OutputSize
+		cborHex string
+	}{
+		{"ADA-only base address", fees.OutputSize{AddressBytes: 57}, "a200583901101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f4041424344454647011a001e8480"},
+		{"ADA-only enterprise address", fees.OutputSize{AddressBytes: 29}, "a200581d61404142434445464748494a4b4c4d4e4f505152535455565758595a5b011a001e8480"},
+		{"base address with datum hash", fees.OutputSize{AddressBytes: 57, HasDatumHash: true}, "a300583901101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f4041424344454647011a001e84800282005820dddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddddd"},
+		{"one NFT, 8-byte name", fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8}, "a200583901101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464701821a001e8480a1581caaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa1484e4654303030303101"},
+		{"ten assets, one policy", fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 10, TotalAssetNameBytes: 100}, "a200583901101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464701821a001e8480a1581caaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa4a544f4b454e3030303030014a544f4b454e3030303031014a544f4b454e3030303032014a544f4b454e3030303033014a544f4b454e3030303034014a544f4b454e3030303035014a544f4b454e3030303036014a544f4b454e3030303037014a544f4b454e3030303038014a544f4b454e303030303901"},
+		{"two policies, 32-byte names", fees.OutputSize{AddressBytes: 57, NumPolicies: 2, NumAssets: 2, TotalAssetNameBytes: 64}, "a200583901101112131415161718191a1b1c1d1e1f202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f404142434445464701821a001e8480a2581caaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa15820414141414141414141414141414141414141414141414141414141414141414101581cbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbba15820424242424242424242424242424242424242424242424242424242424242424201"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cbor, err := hex.DecodeString(tc.cborHex)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := uint64(len(cbor))
+			ok, est, actual, err := fees.IsEstimateConservative(p, tc.out, want)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if actual != want || est != fees.EstimateOutputBytes(tc.out) {
+				t.Errorf("got sizes (%d, %d), want (%d, %d)", est, actual, fees.EstimateOutputBytes(tc.out), want)
+			}
+			if !ok {
+				t.Errorf("estimate %d bytes under-estimates reference %d bytes; the byte model needs updating", est, actual)
+			}
+		})
+	}
+
+	if ok, _, _, _ := fees.IsEstimateConservative(p, fees.OutputSize{AddressBytes: 57}, 77); ok {
+		t.Error("expected false when the actual size exceeds the estimate")
+	}
+	if _, _, _, err := fees.IsEstimateConservative(p, fees.OutputSize{AddressBytes: 57}, 0); !errors.Is(err, fees.ErrZeroBytes) {
+		t.Errorf("got %v, want ErrZeroBytes", err)
+	}
+	if _, _, _, err := fees.IsEstimateConservative(fees.ProtocolParams{}, fees.OutputSize{AddressBytes: 57}, 67); !errors.Is(err, &fees.MinUTxOError{Code: fees.ErrCodeInvalidParams}) {
+		t.Errorf("got %v, want ErrCodeInvalidParams", err)
+	}
+}
+
+func TestCostPerAdditionalAsset(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name         string
+		assetNameLen uint64
+		wantErr      bool
+	}{
+		{"empty name", 0, false},
+		{"8 byte name", 8, false},
+		{"32 byte name", 32, false},
+		{"33 byte name", 33, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.CostPerAdditionalAsset(p, tc.assetNameLen)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			one, _ := fees.MinUTxOForBundle(p, 1, 1, tc.assetNameLen)
+			two, _ := fees.MinUTxOForBundle(p, 1, 2, tc.assetNameLen*2)
+			if got != two-one {
+				t.Errorf("got %d, want %d", got, two-one)
+			}
+			t.Logf("additional asset (%d-byte name): %s", tc.assetNameLen, fees.FormatADA(got))
+		})
+	}
+}
+
+func TestCostPerAdditionalPolicy(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	got, err := fees.CostPerAdditionalPolicy(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// One more policy adds exactly one 28-byte policy hash.
+	if want := 28 * p.CoinsPerUTxOByte; got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+	t.Logf("additional policy: %s", fees.FormatADA(got))
+
+	if _, err := fees.CostPerAdditionalPolicy(fees.ProtocolParams{}); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
+
+func TestOutputSizeClone(t *testing.T) {
+	base := fees.OutputSize{
+		AddressBytes:        57,
+		NumPolicies:         1,
+		NumAssets:           2,
+		TotalAssetNameBytes: 20,
+		HasInlineDatum:      true,
+		InlineDatumBytes:    64,
+	}
+	clone := base.Clone()
+	if clone != base {
+		t.Fatalf("clone %+v differs from base %+v", clone, base)
+	}
+
+	clone.HasDatumHash = true
+	clone.NumAssets = 3
+	if base.HasDatumHash || base.NumAssets != 2 {
+		t.Errorf("mutating the clone changed the base: %+v", base)
+	}
+}
+
+func TestMinUTxOErrorCode(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	_, zeroBytesErr := fees.MinUTxOFromBytes(p, 0)
+	_, nameErr := fees.MinUTxOForNFT(p, 33)
+	_, bundleErr := fees.MinUTxOForBundle(p, 0, 1, 0)
+	_, addrErr := fees.MinUTxOForOutput(p, fees.OutputSize{}, fees.AddressType(99))
+	_, paramErr := fees.MinUTxO(fees.ProtocolParams{}, fees.OutputSize{AddressBytes: 57})
+
+	tests := []struct {
+		name  string
+		err   error
+		want  fees.ErrorCode
+		cause error
+	}{
+		{"zero bytes", zeroBytesErr, fees.ErrCodeZeroBytes, fees.ErrZeroBytes},
+		{"asset name too long", nameErr, fees.ErrCodeAssetNameTooLong, fees.ErrAssetNameTooLong},
+		{"empty bundle", bundleErr, fees.ErrCodeEmptyBundle, fees.ErrEmptyBundle},
+		{"unknown address type", addrErr, fees.ErrCodeUnknownAddressType, fees.ErrUnknownAddressType},
+		{"invalid params", paramErr, fees.ErrCodeInvalidParams, fees.ErrInvalidMinFeeA},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var me *fees.MinUTxOError
+			if !errors.As(tc.err, &me) {
+				t.Fatalf("expected *MinUTxOError, got %T", tc.err)
+			}
+			if me.Code != tc.want {
+				t.Errorf("Code = %d, want %d", me.Code, tc.want)
+			}
+			if !errors.Is(tc.err, &fees.MinUTxOError{Code: tc.want}) {
+				t.Error("errors.Is should match by code")
+			}
+			if errors.Is(tc.err, &fees.MinUTxOError{Code: fees.ErrCodeAboveMaxSupply}) {
+				t.Error("errors.Is should not match a different code")
+			}
+			if !errors.Is(tc.err, tc.cause) {
+				t.Errorf("errors.Is(err, %v) = false, want true via Unwrap", tc.cause)
+			}
+			if errors.Is(tc.err, fees.ErrBelowMinUTxO) {
+				t.Error("errors.Is should not match a different cause")
+			}
+		})
+	}
+
+	if errors.Is(zeroBytesErr, &fees.MinUTxOError{}) {
+		t.Error("a zero code should not match")
+	}
+
+	var pe *fees.ParamError
+	if !errors.As(paramErr, &pe) || pe.Field != "MinFeeA" {
+		t.Errorf("errors.As(%v, *ParamError) = %v, want the MinFeeA *ParamError", paramErr, pe)
+	}
+}
+
+func TestMinUTxOFromComponents(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	if got := fees.UTxOEntryOverheadBytes(); got != 160 {
+		t.Errorf("UTxOEntryOverheadBytes() = %d, want 160", got)
+	}
+	if got := fees.TotalUTxOBytes(125); got != 285 {
+		t.Errorf("TotalUTxOBytes(125) = %d, want 285", got)
+	}
+
+	for _, n := range []uint64{1, 76, 125, 5_000} {
+		got, err := fees.MinUTxOFromComponents(p, n)
+		if err != nil {
+			t.Fatalf("MinUTxOFromComponents(%d): %v", n, err)
+		}
+		want, _ := fees.MinUTxOFromBytes(p, n)
+		if got != want {
+			t.Errorf("MinUTxOFromComponents(%d) = %d, MinUTxOFromBytes = %d", n, got, want)
+		}
+	}
+
+	if _, err := fees.MinUTxOFromComponents(p, 0); err == nil {
+		t.Error("expected error for zero bytes")
+	}
+	if _, err := fees.MinUTxOFromComponents(fees.ProtocolParams{}, 125); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
+
+func TestOutputSizeValidate(t *testing.T) {
+	tests := []struct {
+		name           string
+		out            fees.OutputSize
+		wantViolations int
+	}{
+		{"ada only", fees.OutputSize{AddressBytes: 57}, 0},
+		{"nft with inline datum", fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 32, HasInlineDatum: true, InlineDatumBytes: 80}, 0},
+		{"no address", fees.OutputSize{}, 1},
+		{"assets without policy", fees.OutputSize{AddressBytes: 57, NumAssets: 2}, 1},
+		{"policy without assets", fees.OutputSize{AddressBytes: 57, NumPolicies: 1}, 1},
+		{"asset names too long", fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 33}, 1},
+		{"both datum kinds", fees.OutputSize{AddressBytes: 57, HasDatumHash: true, HasInlineDatum: true, InlineDatumBytes: 10}, 1},
+		{"flags without bytes", fees.OutputSize{AddressBytes: 57, HasInlineDatum: true, HasScriptRef: true}, 2},
+		{"bytes without flags", fees.OutputSize{AddressBytes: 57, InlineDatumBytes: 10, ScriptRefBytes: 10}, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.out.Validate()
+			if tc.wantViolations == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var ve *fees.ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("err = %v, want *ValidationError", err)
+			}
+			if len(ve.Violations) != tc.wantViolations {
+				t.Errorf("violations = %q, want %d", ve.Violations, tc.wantViolations)
+			}
+		})
+	}
+}
+
+func TestMinUTxOForFullOutput(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	got, err := fees.MinUTxOForFullOutput(p, 57, 1, 1, 9, 100, 0, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, _ := fees.MinUTxO(p, fees.OutputSize{
+		AddressBytes:        57,
+		NumPolicies:         1,
+		NumAssets:           1,
+		TotalAssetNameBytes: 9,
+		HasInlineDatum:      true,
+		InlineDatumBytes:    100,
+	})
+	if got != want {
+		t.Errorf("MinUTxOForFullOutput = %d, want %d", got, want)
+	}
+
+	if _, err := fees.MinUTxOForFullOutput(p, 57, 0, 3, 0, 0, 0, false); err != nil {
+		t.Errorf("unvalidated variant should accept inconsistent counts, got %v", err)
+	}
+	var ve *fees.ValidationError
+	if _, err := fees.MinUTxOForFullOutputValidated(p, 57, 0, 3, 0, 0, 0, false); !errors.As(err, &ve) {
+		t.Errorf("validated variant err = %v, want *ValidationError", err)
+	}
+	if v, err := fees.MinUTxOForFullOutputValidated(p, 57, 1, 1, 9, 100, 0, false); err != nil || v != want {
+		t.Errorf("validated variant = %d, %v; want %d", v, err, want)
+	}
+}
+
+func TestMaxMinUTxOBound(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	got, err := fees.MaxMinUTxOBound(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// (5000 - 46) / 43 = 115 assets, whose value serializes to
+	// 46 + 115*43 = 4991 bytes, within MaxValueSize. Estimated output:
+	// 10 + 57 + 9 + 5 + 28 + 115*(12+32+5) + 1024 = 6768 bytes
+	want := uint64(160+6768) * 4310
+	if got != want {
+		t.Errorf("MaxMinUTxOBound = %d, want %d", got, want)
+	}
+
+	nft, _ := fees.MinUTxOForNFT(p, 32)
+	if got <= nft {
+		t.Errorf("bound %d should exceed a single NFT output %d", got, nft)
+	}
+
+	// Too small for any asset: the bound is the ADA-only datum output.
+	p.MaxValueSize = 46
+	small, err := fees.MaxMinUTxOBound(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinUTxO(p, fees.OutputSize{AddressBytes: 57, HasInlineDatum: true, InlineDatumBytes: 1024}); small != want {
+		t.Errorf("MaxMinUTxOBound with MaxValueSize 46 = %d, want %d", small, want)
+	}
+
+	p.MaxValueSize = 0
+	if _, err := fees.MaxMinUTxOBound(p); err == nil {
+		t.Error("expected error for zero MaxValueSize")
+	}
+}
+
+func TestMinUTxOForNFTBundle(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name     string
+		numNFTs  uint64
+		avgName  uint64
+		wantCode fees.ErrorCode
+	}{
+		{"single", 1, 32, 0},
+		{"five", 5, 12, 0},
+		{"empty names", 3, 0, 0},
+		{"name too long", 2, 33, fees.ErrCodeAssetNameTooLong},
+		{"no NFTs", 0, 10, fees.ErrCodeEmptyBundle},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MinUTxOForNFTBundle(p, tc.numNFTs, tc.avgName)
+			if tc.wantCode != 0 {
+				if !errors.Is(err, &fees.MinUTxOError{Code: tc.wantCode}) {
+					t.Fatalf("err = %v, want code %d", err, tc.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want, _ := fees.MinUTxOForBundle(p, tc.numNFTs, tc.numNFTs, tc.numNFTs*tc.avgName)
+			if got != want {
+				t.Errorf("MinUTxOForNFTBundle = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestEstimateOutputBytesFormats(t *testing.T) {
+	tests := []struct {
+		name           string
+		out            fees.OutputSize
+		wantPostAlonzo uint64
+		wantLegacy     uint64
+	}{
+		{"ada only", fees.OutputSize{AddressBytes: 57}, 76, 74},
+		{"datum hash", fees.OutputSize{AddressBytes: 57, HasDatumHash: true}, 108, 106},
+		{"inline datum and script ref", fees.OutputSize{
+			AddressBytes:     57,
+			HasInlineDatum:   true,
+			InlineDatumBytes: 120,
+			HasScriptRef:     true,
+			ScriptRefBytes:   500,
+		}, 696, 74},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fees.EstimateOutputBytesPostAlonzo(tc.out); got != tc.wantPostAlonzo {
+				t.Errorf("EstimateOutputBytesPostAlonzo = %d, want %d", got, tc.wantPostAlonzo)
+			}
+			if got := fees.EstimateOutputBytes(tc.out); got != tc.wantPostAlonzo {
+				t.Errorf("EstimateOutputBytes = %d, want the post-Alonzo size %d", got, tc.wantPostAlonzo)
+			}
+			if got := fees.EstimateOutputBytesLegacy(tc.out); got != tc.wantLegacy {
+				t.Errorf("EstimateOutputBytesLegacy = %d, want %d", got, tc.wantLegacy)
+			}
+		})
+	}
+}
+
+func TestMinUTxOWithMargin(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+	base, _ := fees.MinUTxO(p, out) // 236 * 4310 = 1,017,160
+
+	tests := []struct {
+		name   string
+		bps    uint64
+		want   uint64
+		hasErr bool
+	}{
+		{"no margin", 0, base, false},
+		{"1%", 100, 1_027_332 /* ceil(1,027,331.6) */, false},
+		{"100%", 10_000, 2 * base, false},
+		{"overflow", math.MaxUint64, 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MinUTxOWithMargin(p, out, tc.bps)
+			if (err != nil) != tc.hasErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.hasErr)
+			}
+			if got != tc.want {
+				t.Errorf("MinUTxOWithMargin(%d) = %d, want %d", tc.bps, got, tc.want)
+			}
+		})
+	}
+
+	abs, err := fees.MinUTxOWithAbsoluteMargin(p, out, 50_000)
+	if err != nil || abs != base+50_000 {
+		t.Errorf("MinUTxOWithAbsoluteMargin = %d, %v; want %d", abs, err, base+50_000)
+	}
+	if _, err := fees.MinUTxOWithAbsoluteMargin(p, out, math.MaxUint64); err == nil {
+		t.Error("expected overflow error")
+	}
+}
+
+func TestTokenBundleValueBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		out  fees.OutputSize
+		want uint64
+	}{
+		{"ADA only", fees.OutputSize{AddressBytes: 57}, 9},
+		{"one NFT", fees.OutputSize{NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8}, 67},
+		{"two policies", fees.OutputSize{NumPolicies: 2, NumAssets: 3, TotalAssetNameBytes: 30}, 9 + 5 + 56 + 51 + 30},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := fees.TokenBundleValueBytes(tc.out)
+			if got != tc.want {
+				t.Errorf("TokenBundleValueBytes = %d, want %d", got, tc.want)
+			}
+			// The value is the only part of an output that depends on its
+			// tokens, so it accounts for the whole difference in size.
+			ada := tc.out
+			ada.NumPolicies, ada.NumAssets, ada.TotalAssetNameBytes = 0, 0, 0
+			if diff := fees.EstimateOutputBytes(tc.out) - fees.EstimateOutputBytes(ada); diff != got-9 {
+				t.Errorf("EstimateOutputBytes difference = %d, want %d", diff, got-9)
+			}
+		})
+	}
+}
+
+func TestIsTokenBundleWithinMaxValueSize(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	ok, size, err := fees.IsTokenBundleWithinMaxValueSize(p, fees.OutputSize{NumPolicies: 1, NumAssets: 100, TotalAssetNameBytes: 3_200})
+	if err != nil || !ok || size != 9+5+28+1_700+3_200 {
+		t.Errorf("100 assets = %v, %d, %v; want true, 4942, nil", ok, size, err)
+	}
+	ok, _, err = fees.IsTokenBundleWithinMaxValueSize(p, fees.OutputSize{NumPolicies: 1, NumAssets: 200, TotalAssetNameBytes: 6_400})
+	if err != nil || ok {
+		t.Errorf("200 assets = %v, %v; want false, nil", ok, err)
+	}
+
+	p.MaxValueSize = 0
+	var pe *fees.ParamError
+	_, _, err = fees.IsTokenBundleWithinMaxValueSize(p, fees.OutputSize{})
+	if !errors.As(err, &pe) || !errors.Is(err, &fees.MinUTxOError{Code: fees.ErrCodeInvalidParams}) {
+		t.Errorf("err = %v, want ErrCodeInvalidParams wrapping a *ParamError", err)
+	}
+	_, _, err = fees.IsTokenBundleWithinMaxValueSize(fees.ProtocolParams{}, fees.OutputSize{})
+	if !errors.Is(err, &fees.MinUTxOError{Code: fees.ErrCodeInvalidParams}) || !errors.Is(err, fees.ErrInvalidMinFeeA) {
+		t.Errorf("zero params err = %v, want ErrCodeInvalidParams wrapping ErrInvalidMinFeeA", err)
+	}
+}
+
+func TestIsMinUTxOAboveADAOnly(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	adaOnly := fees.OutputSize{AddressBytes: 57}
+	nft := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8}
+	wantADA, _ := fees.MinUTxO(p, adaOnly)
+	wantNFT, _ := fees.MinUTxO(p, nft)
+
+	tests := []struct {
+		name      string
+		out       fees.OutputSize
+		wantAbove bool
+		wantOut   uint64
+	}{
+		{"ADA only", adaOnly, false, wantADA},
+		{"one NFT", nft, true, wantNFT},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			above, outMin, adaMin, err := fees.IsMinUTxOAboveADAOnly(p, tc.out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if above != tc.wantAbove || outMin != tc.wantOut || adaMin != wantADA {
+				t.Errorf("got %v, %d, %d; want %v, %d, %d", above, outMin, adaMin, tc.wantAbove, tc.wantOut, wantADA)
+			}
+		})
+	}
+
+	bad := p
+	bad.CoinsPerUTxOByte = 0
+	if _, _, _, err := fees.IsMinUTxOAboveADAOnly(bad, nft); err == nil {
+		t.Error("expected error for invalid params")
+	}
+}
+
+func TestMinUTxODeltaForAsset(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	adaOnly := fees.OutputSize{AddressBytes: 57}
+	oneNFT := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8}
+
+	tests := []struct {
+		name      string
+		existing  fees.OutputSize
+		nameBytes uint64
+		newPolicy bool
+		want      uint64
+	}{
+		// The first token adds the bundle overhead, a policy, and an asset.
+		{"first token", adaOnly, 8, true, (5 + 28 + 17 + 8) * 4_310},
+		{"same policy", oneNFT, 8, false, (17 + 8) * 4_310},
+		{"new policy", oneNFT, 8, true, (28 + 17 + 8) * 4_310},
+		{"empty name", oneNFT, 0, false, 17 * 4_310},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.MinUTxODeltaForAsset(p, tc.existing, tc.nameBytes, tc.newPolicy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("MinUTxODeltaForAsset = %d, want %d", got, tc.want)
+			}
+		})
+	}
+
+	// Matches CostPerAdditionalAsset for a one-policy bundle.
+	cost, _ := fees.CostPerAdditionalAsset(p, 32)
+	delta, _ := fees.MinUTxODeltaForAsset(p, fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 32}, 32, false)
+	if delta != cost {
+		t.Errorf("delta = %d, want CostPerAdditionalAsset %d", delta, cost)
+	}
+
+	if _, err := fees.MinUTxODeltaForAsset(p, oneNFT, 33, false); !errors.Is(err, fees.ErrAssetNameTooLong) {
+		t.Errorf("33-byte name err = %v, want ErrAssetNameTooLong", err)
+	}
+	if _, err := fees.MinUTxODeltaForAsset(p, adaOnly, 8, false); !errors.Is(err, fees.ErrEmptyBundle) {
+		t.Errorf("no policy err = %v, want ErrEmptyBundle", err)
+	}
+}
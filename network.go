@@ -0,0 +1,116 @@
+package fees
+
+import "fmt"
+
+// NetworkID identifies a Cardano network as encoded in addresses and the
+// transaction body network_id field: 0 for any testnet, 1 for mainnet.
+type NetworkID uint8
+
+const (
+	// NetworkTestnet is the network ID shared by all Cardano testnets
+	// (preview, preprod, SanchoNet).
+	NetworkTestnet NetworkID = 0
+
+	// NetworkMainnet is the network ID of Cardano mainnet.
+	NetworkMainnet NetworkID = 1
+)
+
+// Network magic numbers of the public Cardano networks.
+const (
+	MainnetNetworkMagic   uint32 = 764824073
+	PreProdNetworkMagic   uint32 = 1
+	PreviewNetworkMagic   uint32 = 2
+	SanchoNetNetworkMagic uint32 = 4
+)
+
+// String returns "mainnet", "testnet", or "NetworkID(n)" for unknown values.
+func (n NetworkID) String() string {
+	switch n {
+	case NetworkTestnet:
+		return "testnet"
+	case NetworkMainnet:
+		return "mainnet"
+	default:
+		return fmt.Sprintf("NetworkID(%d)", uint8(n))
+	}
+}
+
+// NetworkParams binds a set of ProtocolParams to the network they were
+// fetched from. Passing a NetworkParams rather than bare ProtocolParams
+// makes it explicit which network a calculation is for, and prevents
+// mainnet params from silently being used for a testnet transaction.
+type NetworkParams struct {
+	// Network is the network the params belong to.
+	Network NetworkID
+
+	// Params are the protocol parameters for Network.
+	Params ProtocolParams
+}
+
+// NewMainnetNetworkParams binds p to Cardano mainnet.
+//
+// Example:
+//
+//	np := fees.NewMainnetNetworkParams(fees.DefaultMainnetParams())
+func NewMainnetNetworkParams(p ProtocolParams) NetworkParams {
+	return NetworkParams{Network: NetworkMainnet, Params: p}
+}
+
+// NewTestnetNetworkParams binds p to a Cardano testnet.
+//
+// Example:
+//
+//	np := fees.NewTestnetNetworkParams(fees.DefaultPreviewParams())
+func NewTestnetNetworkParams(p ProtocolParams) NetworkParams {
+	return NetworkParams{Network: NetworkTestnet, Params: p}
+}
+
+// Fee returns MinFee for txSizeBytes using the bound params.
+//
+// Example:
+//
+//	np := fees.NewMainnetNetworkParams(fees.DefaultMainnetParams())
+//	fee, err := np.Fee(350)
+func (n NetworkParams) Fee(txSizeBytes uint64) (uint64, error) {
+	return MinFee(n.Params, txSizeBytes)
+}
+
+// RequireNetwork returns an error if n is not bound to want. Call it at
+// the boundary where a transaction's target network is known.
+//
+// Example:
+//
+//	if err := np.RequireNetwork(fees.NetworkMainnet); err != nil {
+//		return err
+//	}
+func (n NetworkParams) RequireNetwork(want NetworkID) error {
+	if n.Network != want {
+		return &ParamError{
+			Field:   "Network",
+			Message: fmt.Sprintf("params are for %s, not %s", n.Network, want),
+		}
+	}
+	return nil
+}
+
+// TxNetworkIDFieldBytes returns the size of the optional network_id
+// transaction body field: a 1-byte map key and a 1-byte CBOR uint, 2 bytes.
+// Including it makes the ledger reject the transaction on the wrong
+// network.
+//
+// Example:
+//
+//	fees.TxNetworkIDFieldBytes() // 2
+func TxNetworkIDFieldBytes() uint64 {
+	return 2
+}
+
+// EstimateTxSizeWithNetworkID returns base plus the network_id field
+// overhead.
+//
+// Example:
+//
+//	size := fees.EstimateTxSizeWithNetworkID(405) // 407
+func EstimateTxSizeWithNetworkID(base uint64) uint64 {
+	return base + TxNetworkIDFieldBytes()
+}
@@ -0,0 +1,114 @@
+package fees_test
+
+import (
+	"errors"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestNetworkIDString(t *testing.T) {
+	tests := []struct {
+		id   fees.NetworkID
+		want string
+	}{
+		{fees.NetworkTestnet, "testnet"},
+		{fees.NetworkMainnet, "mainnet"},
+		{fees.NetworkID(7), "NetworkID(7)"},
+	}
+
+	for _, tc := range tests {
+		if got := tc.id.String(); got != tc.want {
+			t.Errorf("NetworkID(%d).String() = %q, want %q", uint8(tc.id), got, tc.want)
+		}
+	}
+}
+
+func TestNetworkParams(t *testing.T) {
+	mainnet := fees.NewMainnetNetworkParams(fees.DefaultMainnetParams())
+	testnet := fees.NewTestnetNetworkParams(fees.DefaultPreviewParams())
+
+	if mainnet.Network != fees.NetworkMainnet {
+		t.Errorf("mainnet.Network = %v", mainnet.Network)
+	}
+	if testnet.Network != fees.NetworkTestnet {
+		t.Errorf("testnet.Network = %v", testnet.Network)
+	}
+
+	got, err := mainnet.Fee(350)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := fees.MinFee(fees.DefaultMainnetParams(), 350)
+	if got != want {
+		t.Errorf("Fee(350) = %d, want %d", got, want)
+	}
+
+	if err := mainnet.RequireNetwork(fees.NetworkMainnet); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err = testnet.RequireNetwork(fees.NetworkMainnet)
+	var pe *fees.ParamError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *ParamError, got %T", err)
+	}
+	if pe.Field != "Network" {
+		t.Errorf("Field = %q, want Network", pe.Field)
+	}
+}
+
+func TestProtocolParamsNetwork(t *testing.T) {
+	tests := []struct {
+		name        string
+		p           fees.ProtocolParams
+		wantMainnet bool
+		wantName    string
+	}{
+		{"mainnet", fees.DefaultMainnetParams(), true, "mainnet"},
+		{"preprod", fees.DefaultPreProdParams(), false, "preprod"},
+		{"preview", fees.DefaultPreviewParams(), false, "preview"},
+		{"sanchonet", fees.DefaultSanchoNetParams(), false, "sanchonet"},
+		{"hand-built", fees.ProtocolParams{}, false, "unknown"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.p.IsMainnet(); got != tc.wantMainnet {
+				t.Errorf("IsMainnet() = %v, want %v", got, tc.wantMainnet)
+			}
+			if got := tc.p.IsTestnet(); got == tc.wantMainnet {
+				t.Errorf("IsTestnet() = %v, want %v", got, !tc.wantMainnet)
+			}
+			if got := tc.p.NetworkName(); got != tc.wantName {
+				t.Errorf("NetworkName() = %q, want %q", got, tc.wantName)
+			}
+		})
+	}
+
+	for name, p := range fees.AllDefaultParams() {
+		if p.NetworkName() != name {
+			t.Errorf("AllDefaultParams()[%q].NetworkName() = %q", name, p.NetworkName())
+		}
+	}
+}
+
+func TestTxNetworkIDField(t *testing.T) {
+	if got := fees.EstimateTxSizeWithNetworkID(405) - 405; got != 2 {
+		t.Errorf("network_id overhead = %d bytes, want 2", got)
+	}
+
+	p := fees.DefaultMainnetParams()
+	opts := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2}
+	without, err := fees.EstimateFeeWithOptions(p, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.IncludeNetworkID = true
+	with, err := fees.EstimateFeeWithOptions(p, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if with-without != p.MinFeeA*fees.TxNetworkIDFieldBytes() {
+		t.Errorf("fee difference = %d, want %d", with-without, p.MinFeeA*2)
+	}
+}
@@ -10,6 +10,12 @@
 // Ledger spec:      https://github.com/intersectmbo/cardano-ledger
 package fees
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
 // ProtocolParams holds the subset of Cardano protocol parameters needed
 // for fee and minUTxO calculations. All fields use Lovelace as the unit
 // unless noted otherwise.
@@ -36,6 +42,72 @@ type ProtocolParams struct {
 	// MaxTxSize is the maximum allowed transaction size in bytes.
 	// Mainnet: 16384
 	MaxTxSize uint64
+
+	// MaxValueSize is the maximum serialized size, in bytes, of the value
+	// (ADA plus tokens) held in a single output. Optional: only needed for
+	// output-size bounds, and not checked by Validate.
+	// Mainnet: 5000
+	MaxValueSize uint64
+
+	// MaxBlockBodySize is the maximum size, in bytes, of a block body, the
+	// space shared by its transactions. Optional: only needed for block
+	// capacity calculations, and not checked by Validate.
+	// Mainnet: 90112
+	MaxBlockBodySize uint64
+
+	// MaxBlockHeaderSize is the maximum size, in bytes, of a block header.
+	// Optional, and not checked by Validate.
+	// Mainnet: 1100
+	MaxBlockHeaderSize uint64
+
+	// CollateralPercentage is the collateral a Plutus transaction must post,
+	// as a percentage of its fee. Optional: only needed for collateral
+	// calculations, and not checked by Validate.
+	// Mainnet: 150
+	CollateralPercentage uint64
+
+	// MinFeeRefScriptCostPerByte is the base Lovelace price per byte of
+	// reference scripts used by a transaction (Conway). Optional: only
+	// needed for reference script fees, and not checked by Validate.
+	// Mainnet: 15
+	MinFeeRefScriptCostPerByte uint64
+
+	// GovActionDeposit is the refundable deposit for submitting a Conway
+	// governance action. Optional: only needed for governance calculations,
+	// and not checked by Validate.
+	// Mainnet: 100000000000 (100,000 ADA)
+	GovActionDeposit uint64
+
+	// KeyDeposit is the refundable deposit for registering a stake key.
+	// Optional: only needed for certificate calculations, and not checked
+	// by Validate.
+	// Mainnet: 2000000 (2 ADA)
+	KeyDeposit uint64
+
+	// PoolDeposit is the refundable deposit for registering a stake pool.
+	// Optional, and not checked by Validate.
+	// Mainnet: 500000000 (500 ADA)
+	PoolDeposit uint64
+
+	// DRepDeposit is the refundable deposit for registering as a Conway
+	// delegated representative. Optional, and not checked by Validate.
+	// Mainnet: 500000000 (500 ADA)
+	DRepDeposit uint64
+
+	// NetworkID is the ledger network ID the params belong to: NetworkMainnet
+	// or NetworkTestnet. The zero value is NetworkTestnet, so params built by
+	// hand are treated as testnet params unless marked otherwise.
+	NetworkID NetworkID
+
+	// NetworkMagic is the network magic number, which distinguishes the
+	// individual testnets. Optional: zero means unspecified.
+	// Mainnet: 764824073
+	NetworkMagic uint32
+
+	// Epoch is the epoch the params were taken from, for provenance when
+	// comparing calculations across environments. Optional: zero means
+	// unspecified, and Validate does not check it.
+	Epoch uint64
 }
 
 // DefaultMainnetParams returns ProtocolParams populated with typical Cardano
@@ -48,13 +120,64 @@ type ProtocolParams struct {
 //	f ee := fees.MinFee(p, 300)
 func DefaultMainnetParams() ProtocolParams {
 	return ProtocolParams{
-		MinFeeA:          44,
-		MinFeeB:          155381,
-		CoinsPerUTxOByte: 4310,
-		MaxTxSize:        16384,
+		MinFeeA:                    44,
+		MinFeeB:                    155381,
+		CoinsPerUTxOByte:           4310,
+		MaxTxSize:                  16384,
+		MaxValueSize:               5000,
+		MaxBlockBodySize:           90112,
+		MaxBlockHeaderSize:         1100,
+		CollateralPercentage:       150,
+		MinFeeRefScriptCostPerByte: 15,
+		GovActionDeposit:           100_000_000_000,
+		KeyDeposit:                 2_000_000,
+		PoolDeposit:                500_000_000,
+		DRepDeposit:                500_000_000,
+		NetworkID:                  NetworkMainnet,
+		NetworkMagic:               MainnetNetworkMagic,
+		// The epoch these values were snapshotted from; not live data.
+		Epoch: 540,
 	}
 }
 
+// DefaultMainnetCoinsPerUTxOByte returns DefaultMainnetParams().CoinsPerUTxOByte.
+// Like the other DefaultMainnet accessors below, it names the one parameter
+// a piece of code depends on.
+//
+// Example:
+//
+//	fees.DefaultMainnetCoinsPerUTxOByte() // 4310
+func DefaultMainnetCoinsPerUTxOByte() uint64 {
+	return DefaultMainnetParams().CoinsPerUTxOByte
+}
+
+// DefaultMainnetMinFeeA returns DefaultMainnetParams().MinFeeA.
+//
+// Example:
+//
+//	fees.DefaultMainnetMinFeeA() // 44
+func DefaultMainnetMinFeeA() uint64 {
+	return DefaultMainnetParams().MinFeeA
+}
+
+// DefaultMainnetMinFeeB returns DefaultMainnetParams().MinFeeB.
+//
+// Example:
+//
+//	fees.DefaultMainnetMinFeeB() // 155381
+func DefaultMainnetMinFeeB() uint64 {
+	return DefaultMainnetParams().MinFeeB
+}
+
+// DefaultMainnetMaxTxSize returns DefaultMainnetParams().MaxTxSize.
+//
+// Example:
+//
+//	fees.DefaultMainnetMaxTxSize() // 16384
+func DefaultMainnetMaxTxSize() uint64 {
+	return DefaultMainnetParams().MaxTxSize
+}
+
 // DefaultPreviewParams returns ProtocolParams for the Cardano preview testnet.
 // Values may differ from mainnet; always verify against live protocol parameters.
 //
@@ -63,10 +186,172 @@ func DefaultMainnetParams() ProtocolParams {
 //	p := fees.DefaultPreviewParams()
 func DefaultPreviewParams() ProtocolParams {
 	return ProtocolParams{
-		MinFeeA:          44,
-		MinFeeB:          155381,
-		CoinsPerUTxOByte: 4310,
-		MaxTxSize:        16384,
+		MinFeeA:                    44,
+		MinFeeB:                    155381,
+		CoinsPerUTxOByte:           4310,
+		MaxTxSize:                  16384,
+		MaxValueSize:               5000,
+		MaxBlockBodySize:           90112,
+		MaxBlockHeaderSize:         1100,
+		CollateralPercentage:       150,
+		MinFeeRefScriptCostPerByte: 15,
+		GovActionDeposit:           100_000_000_000,
+		KeyDeposit:                 2_000_000,
+		PoolDeposit:                500_000_000,
+		DRepDeposit:                500_000_000,
+		NetworkID:                  NetworkTestnet,
+		NetworkMagic:               PreviewNetworkMagic,
+	}
+}
+
+// DefaultPreProdParams returns ProtocolParams for the Cardano pre-production
+// testnet. Values may differ from mainnet; always verify against live
+// protocol parameters.
+//
+// Example:
+//
+//	p := fees.DefaultPreProdParams()
+func DefaultPreProdParams() ProtocolParams {
+	return ProtocolParams{
+		MinFeeA:                    44,
+		MinFeeB:                    155381,
+		CoinsPerUTxOByte:           4310,
+		MaxTxSize:                  16384,
+		MaxValueSize:               5000,
+		MaxBlockBodySize:           90112,
+		MaxBlockHeaderSize:         1100,
+		CollateralPercentage:       150,
+		MinFeeRefScriptCostPerByte: 15,
+		GovActionDeposit:           100_000_000_000,
+		KeyDeposit:                 2_000_000,
+		PoolDeposit:                500_000_000,
+		DRepDeposit:                500_000_000,
+		NetworkID:                  NetworkTestnet,
+		NetworkMagic:               PreProdNetworkMagic,
+	}
+}
+
+// DefaultSanchoNetParams returns ProtocolParams for SanchoNet, the Conway
+// governance testnet. Values may differ from mainnet; always verify against
+// live protocol parameters.
+//
+// Example:
+//
+//	p := fees.DefaultSanchoNetParams()
+func DefaultSanchoNetParams() ProtocolParams {
+	return ProtocolParams{
+		MinFeeA:                    44,
+		MinFeeB:                    155381,
+		CoinsPerUTxOByte:           4310,
+		MaxTxSize:                  16384,
+		MaxValueSize:               5000,
+		MaxBlockBodySize:           90112,
+		MaxBlockHeaderSize:         1100,
+		CollateralPercentage:       150,
+		MinFeeRefScriptCostPerByte: 15,
+		GovActionDeposit:           100_000_000_000,
+		KeyDeposit:                 2_000_000,
+		PoolDeposit:                500_000_000,
+		DRepDeposit:                500_000_000,
+		NetworkID:                  NetworkTestnet,
+		NetworkMagic:               SanchoNetNetworkMagic,
+	}
+}
+
+// AllDefaultParams returns the default ProtocolParams for every supported
+// network, keyed by lowercase network name: "mainnet", "preprod",
+// "preview", and "sanchonet". The map is freshly allocated on each call.
+//
+// Example:
+//
+//	for name, p := range fees.AllDefaultParams() {
+//		fee, _ := fees.MinFee(p, 350)
+//		fmt.Println(name, fee)
+//	}
+func AllDefaultParams() map[string]ProtocolParams {
+	return map[string]ProtocolParams{
+		"mainnet":   DefaultMainnetParams(),
+		"preview":   DefaultPreviewParams(),
+		"preprod":   DefaultPreProdParams(),
+		"sanchonet": DefaultSanchoNetParams(),
+	}
+}
+
+// SupportedNetworks returns the sorted names of the networks in
+// AllDefaultParams.
+//
+// Example:
+//
+//	fees.SupportedNetworks() // ["mainnet" "preprod" "preview" "sanchonet"]
+func SupportedNetworks() []string {
+	all := AllDefaultParams()
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// HasEpoch reports whether p records the epoch it was taken from.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	if p.HasEpoch() {
+//		log.Printf("using params from epoch %d", p.Epoch)
+//	}
+func (p ProtocolParams) HasEpoch() bool {
+	return p.Epoch != 0
+}
+
+// Copy returns a deep copy of p. Prefer Copy over plain assignment when
+// deriving modified params (for example, simulating a governance change to
+// MinFeeA): ProtocolParams currently holds only value fields, but Copy will
+// keep copies independent if pointer or slice fields are ever added.
+//
+// Example:
+//
+//	proposed := fees.DefaultMainnetParams().Copy()
+//	proposed.MinFeeA = 48
+func (p ProtocolParams) Copy() ProtocolParams {
+	return p
+}
+
+// IsMainnet reports whether p is marked as mainnet params.
+//
+// Example:
+//
+//	if !p.IsMainnet() {
+//		return errors.New("refusing to price a mainnet transaction with testnet params")
+//	}
+func (p ProtocolParams) IsMainnet() bool {
+	return p.NetworkID == NetworkMainnet
+}
+
+// IsTestnet reports whether p is marked as testnet params.
+func (p ProtocolParams) IsTestnet() bool {
+	return p.NetworkID == NetworkTestnet
+}
+
+// NetworkName returns the name of the network identified by p.NetworkMagic:
+// "mainnet", "preprod", "preview", "sanchonet", or "unknown".
+//
+// Example:
+//
+//	fees.DefaultPreviewParams().NetworkName() // "preview"
+func (p ProtocolParams) NetworkName() string {
+	switch p.NetworkMagic {
+	case MainnetNetworkMagic:
+		return "mainnet"
+	case PreProdNetworkMagic:
+		return "preprod"
+	case PreviewNetworkMagic:
+		return "preview"
+	case SanchoNetNetworkMagic:
+		return "sanchonet"
+	default:
+		return "unknown"
 	}
 }
 
@@ -95,6 +380,247 @@ func (p ProtocolParams) Validate() error {
 	return nil
 }
 
+// String returns a human-readable multi-line summary of the parameters,
+// with field names aligned and Lovelace values thousands-separated.
+// It implements fmt.Stringer, and is what the %s verb prints; see Format
+// for %v and %+v.
+//
+// Example:
+//
+//	fmt.Println(fees.DefaultMainnetParams().String())
+//	// MinFeeA:          44 Lovelace
+//	// MinFeeB:          155,381 Lovelace
+//	// CoinsPerUTxOByte: 4,310 Lovelace
+//	// MaxTxSize:        16,384 bytes
+func (p ProtocolParams) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-17s %s\n", "MinFeeA:", FormatLovelaceWithSeparator(p.MinFeeA))
+	fmt.Fprintf(&b, "%-17s %s\n", "MinFeeB:", FormatLovelaceWithSeparator(p.MinFeeB))
+	fmt.Fprintf(&b, "%-17s %s\n", "CoinsPerUTxOByte:", FormatLovelaceWithSeparator(p.CoinsPerUTxOByte))
+	fmt.Fprintf(&b, "%-17s %s bytes", "MaxTxSize:", formatThousands(p.MaxTxSize))
+	return b.String()
+}
+
+// paramUnit says how Format displays a ProtocolParams field.
+type paramUnit uint8
+
+const (
+	unitNone paramUnit = iota
+	unitLovelace
+	unitLovelacePerByte
+	unitBytes
+	unitPercent
+)
+
+// paramField is one numeric ProtocolParams field, for Format.
+type paramField struct {
+	name  string
+	value uint64
+	unit  paramUnit
+}
+
+// fields lists p's numeric fields in declaration order. NetworkID is
+// formatted separately, by name.
+func (p ProtocolParams) fields() []paramField {
+	return []paramField{
+		{"MinFeeA", p.MinFeeA, unitLovelacePerByte},
+		{"MinFeeB", p.MinFeeB, unitLovelace},
+		{"CoinsPerUTxOByte", p.CoinsPerUTxOByte, unitLovelacePerByte},
+		{"MaxTxSize", p.MaxTxSize, unitBytes},
+		{"MaxValueSize", p.MaxValueSize, unitBytes},
+		{"MaxBlockBodySize", p.MaxBlockBodySize, unitBytes},
+		{"MaxBlockHeaderSize", p.MaxBlockHeaderSize, unitBytes},
+		{"CollateralPercentage", p.CollateralPercentage, unitPercent},
+		{"MinFeeRefScriptCostPerByte", p.MinFeeRefScriptCostPerByte, unitLovelacePerByte},
+		{"GovActionDeposit", p.GovActionDeposit, unitLovelace},
+		{"KeyDeposit", p.KeyDeposit, unitLovelace},
+		{"PoolDeposit", p.PoolDeposit, unitLovelace},
+		{"DRepDeposit", p.DRepDeposit, unitLovelace},
+		{"NetworkMagic", uint64(p.NetworkMagic), unitNone},
+		{"Epoch", p.Epoch, unitNone},
+	}
+}
+
+// Format implements fmt.Formatter. The %v verb prints every field as
+// space-separated Key=value pairs with raw values, which are easy to parse
+// back. %+v prints a multi-line block that also shows Lovelace amounts,
+// such as KeyDeposit and PoolDeposit, in ADA. %s prints String.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fmt.Sprintf("%v", p)
+//	// "MinFeeA=44 MinFeeB=155381 CoinsPerUTxOByte=4310 MaxTxSize=16384 ..."
+//	fmt.Sprintf("%+v", p)
+//	// ProtocolParams{
+//	//   MinFeeA:                    44 Lovelace/byte
+//	//   MinFeeB:                    155381 Lovelace (0.155381 ADA)
+//	//   ...
+//	//   KeyDeposit:                 2000000 Lovelace (2.000000 ADA)
+//	//   ...
+//	// }
+func (p ProtocolParams) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprint(f, p.detailed())
+			return
+		}
+		fmt.Fprint(f, p.keyValues())
+	case 's':
+		fmt.Fprint(f, p.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(fees.ProtocolParams=%s)", verb, p.keyValues())
+	}
+}
+
+// keyValues returns the %v form of p.
+func (p ProtocolParams) keyValues() string {
+	var b strings.Builder
+	for i, field := range p.fields() {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		fmt.Fprintf(&b, "%s=%d", field.name, field.value)
+	}
+	fmt.Fprintf(&b, " NetworkID=%s", p.NetworkID)
+	return b.String()
+}
+
+// detailed returns the %+v form of p.
+func (p ProtocolParams) detailed() string {
+	var b strings.Builder
+	b.WriteString("ProtocolParams{\n")
+	for _, field := range p.fields() {
+		fmt.Fprintf(&b, "  %-27s %d", field.name+":", field.value)
+		switch field.unit {
+		case unitLovelace:
+			fmt.Fprintf(&b, " Lovelace (%s ADA)", ToADAString(field.value))
+		case unitLovelacePerByte:
+			b.WriteString(" Lovelace/byte")
+		case unitBytes:
+			b.WriteString(" bytes")
+		case unitPercent:
+			b.WriteString("%")
+		}
+		b.WriteByte('\n')
+	}
+	fmt.Fprintf(&b, "  %-27s %d (%s)\n}", "NetworkID:", uint8(p.NetworkID), p.NetworkID)
+	return b.String()
+}
+
+// FeeFormula returns the MinFee formula with p's values substituted, for
+// documentation generators, API responses, and debug logs.
+//
+// Example:
+//
+//	fees.DefaultMainnetParams().FeeFormula()
+//	// "fee = 44 × txSizeBytes + 155,381 Lovelace"
+func (p ProtocolParams) FeeFormula() string {
+	return fmt.Sprintf("fee = %s × txSizeBytes + %s Lovelace", formatThousands(p.MinFeeA), formatThousands(p.MinFeeB))
+}
+
+// MinUTxOFormula returns the CIP-55 minUTxO formula with p's
+// CoinsPerUTxOByte substituted.
+//
+// Example:
+//
+//	fees.DefaultMainnetParams().MinUTxOFormula()
+//	// "minUTxO = (160 + serializedOutputBytes) × 4,310 Lovelace"
+func (p ProtocolParams) MinUTxOFormula() string {
+	return fmt.Sprintf("minUTxO = (%d + serializedOutputBytes) × %s Lovelace", utxoEntryOverheadBytes, formatThousands(p.CoinsPerUTxOByte))
+}
+
+// MinFeeForByte returns the fee for one byte of transaction size,
+// p.MinFeeA. It is the fee coefficient alone; MinFeeB is charged once per
+// transaction, not per byte.
+//
+// Example:
+//
+//	fees.DefaultMainnetParams().MinFeeForByte() // 44
+func (p ProtocolParams) MinFeeForByte() uint64 {
+	return p.MinFeeA
+}
+
+// FeeForBytes returns the size-dependent part of the fee for n bytes,
+// p.MinFeeA × n, without MinFeeB. It does not validate p or check n
+// against MaxTxSize, and the product wraps around silently if it exceeds
+// math.MaxUint64. Use MinFee for the full fee, which checks all three.
+//
+// Example:
+//
+//	fees.DefaultMainnetParams().FeeForBytes(300) // 13,200
+func (p ProtocolParams) FeeForBytes(n uint64) uint64 {
+	return p.MinFeeA * n
+}
+
+// CheckProtocolParamsCompatibility reports whether a and b agree on every
+// field that is non-zero in both, for verifying params merged from several
+// API sources. It returns true and an empty slice when they agree, or false
+// and one "Field: a != b" description per conflicting field.
+//
+// NetworkTestnet is the zero NetworkID, so NetworkID is compared whenever
+// both a and b identify their network, with a non-zero NetworkID or
+// NetworkMagic; a mainnet/testnet mismatch is then always reported.
+//
+// Example:
+//
+//	ok, conflicts := fees.CheckProtocolParamsCompatibility(fromBlockfrost, fromOgmios)
+//	if !ok {
+//		log.Printf("param sources disagree: %s", strings.Join(conflicts, "; "))
+//	}
+func CheckProtocolParamsCompatibility(a, b ProtocolParams) (bool, []string) {
+	fields := []struct {
+		name string
+		a, b uint64
+	}{
+		{"MinFeeA", a.MinFeeA, b.MinFeeA},
+		{"MinFeeB", a.MinFeeB, b.MinFeeB},
+		{"CoinsPerUTxOByte", a.CoinsPerUTxOByte, b.CoinsPerUTxOByte},
+		{"MaxTxSize", a.MaxTxSize, b.MaxTxSize},
+		{"MaxValueSize", a.MaxValueSize, b.MaxValueSize},
+		{"MaxBlockBodySize", a.MaxBlockBodySize, b.MaxBlockBodySize},
+		{"MaxBlockHeaderSize", a.MaxBlockHeaderSize, b.MaxBlockHeaderSize},
+		{"CollateralPercentage", a.CollateralPercentage, b.CollateralPercentage},
+		{"MinFeeRefScriptCostPerByte", a.MinFeeRefScriptCostPerByte, b.MinFeeRefScriptCostPerByte},
+		{"GovActionDeposit", a.GovActionDeposit, b.GovActionDeposit},
+		{"KeyDeposit", a.KeyDeposit, b.KeyDeposit},
+		{"PoolDeposit", a.PoolDeposit, b.PoolDeposit},
+		{"DRepDeposit", a.DRepDeposit, b.DRepDeposit},
+		{"NetworkID", uint64(a.NetworkID), uint64(b.NetworkID)},
+		{"NetworkMagic", uint64(a.NetworkMagic), uint64(b.NetworkMagic)},
+		{"Epoch", a.Epoch, b.Epoch},
+	}
+
+	bothNetworksKnown := a.hasNetwork() && b.hasNetwork()
+	conflicts := []string{}
+	for _, f := range fields {
+		set := f.a != 0 && f.b != 0 || f.name == "NetworkID" && bothNetworksKnown
+		if set && f.a != f.b {
+			conflicts = append(conflicts, fmt.Sprintf("%s: %d != %d", f.name, f.a, f.b))
+		}
+	}
+	return len(conflicts) == 0, conflicts
+}
+
+// hasNetwork reports whether p says which network it belongs to. A zero
+// NetworkID alone is ambiguous: it is both NetworkTestnet and unset.
+func (p ProtocolParams) hasNetwork() bool {
+	return p.NetworkID != NetworkTestnet || p.NetworkMagic != 0
+}
+
+// Sentinel errors for matching a *ParamError by field with errors.Is,
+// regardless of its message:
+//
+//	if errors.Is(err, fees.ErrInvalidCoinsPerUTxOByte) { ... }
+var (
+	ErrInvalidMinFeeA              = &ParamError{Field: "MinFeeA", Message: "invalid value"}
+	ErrInvalidMinFeeB              = &ParamError{Field: "MinFeeB", Message: "invalid value"}
+	ErrInvalidCoinsPerUTxOByte     = &ParamError{Field: "CoinsPerUTxOByte", Message: "invalid value"}
+	ErrInvalidMaxTxSize            = &ParamError{Field: "MaxTxSize", Message: "invalid value"}
+	ErrInvalidCollateralPercentage = &ParamError{Field: "CollateralPercentage", Message: "invalid value"}
+)
+
 // ParamError is returned when a ProtocolParams field is invalid.
 type ParamError struct {
 	// Field is the name of the invalid parameter.
@@ -105,4 +631,11 @@ type ParamError struct {
 
 func (e *ParamError) Error() string {
 	return "fees: invalid protocol param " + e.Field + ": " + e.Message
-}
\ No newline at end of file
+}
+
+// Is reports whether target is a *ParamError for the same field, so that
+// errors.Is(err, fees.ErrInvalidMinFeeA) matches any MinFeeA error.
+func (e *ParamError) Is(target error) bool {
+	t, ok := target.(*ParamError)
+	return ok && t.Field == e.Field
+}
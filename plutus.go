@@ -0,0 +1,316 @@
+package fees
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+// ExUnits is a Plutus execution budget, measured in abstract memory units
+// and CPU steps. Script redeemers declare an ExUnits budget which the
+// transaction pays for at the rates given by ExecutionPrices.
+type ExUnits struct {
+	// Memory is the memory budget in abstract memory units.
+	Memory uint64
+
+	// Steps is the CPU budget in abstract execution steps.
+	Steps uint64
+}
+
+// String returns the budget with thousands-separated values.
+//
+// Example:
+//
+//	fees.ExUnits{Memory: 1000, Steps: 5000000}.String()
+//	// "ExUnits{Memory: 1,000, Steps: 5,000,000}"
+func (e ExUnits) String() string {
+	return fmt.Sprintf("ExUnits{Memory: %s, Steps: %s}", formatThousands(e.Memory), formatThousands(e.Steps))
+}
+
+// Format implements fmt.Formatter. The %v and %s verbs produce the same
+// output as String; %+v produces the raw, unseparated values, which is
+// easier to copy back into Go source.
+//
+// Example:
+//
+//	u := fees.ExUnits{Memory: 1000, Steps: 5000000}
+//	fmt.Sprintf("%v", u)  // "ExUnits{Memory: 1,000, Steps: 5,000,000}"
+//	fmt.Sprintf("%+v", u) // "ExUnits{Memory: 1000, Steps: 5000000}"
+func (e ExUnits) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, "ExUnits{Memory: %d, Steps: %d}", e.Memory, e.Steps)
+			return
+		}
+		fmt.Fprint(f, e.String())
+	case 's':
+		fmt.Fprint(f, e.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(fees.ExUnits=%s)", verb, e.String())
+	}
+}
+
+// DefaultMaxTxExecutionUnits returns the mainnet per-transaction Plutus
+// execution budget (maxTxExecutionUnits) as of the Conway era. Always fetch
+// live params for production use — this may change via governance.
+//
+// Example:
+//
+//	max := fees.DefaultMaxTxExecutionUnits() // {Memory: 14,000,000, Steps: 10,000,000,000}
+func DefaultMaxTxExecutionUnits() ExUnits {
+	return ExUnits{Memory: 14_000_000, Steps: 10_000_000_000}
+}
+
+// DefaultMaxBlockExecutionUnits returns the mainnet per-block Plutus
+// execution budget (maxBlockExecutionUnits) as of the Conway era.
+//
+// Example:
+//
+//	max := fees.DefaultMaxBlockExecutionUnits() // {Memory: 62,000,000, Steps: 20,000,000,000}
+func DefaultMaxBlockExecutionUnits() ExUnits {
+	return ExUnits{Memory: 62_000_000, Steps: 20_000_000_000}
+}
+
+// ExecutionBudgetUtilization returns how much of max the used budget
+// consumes, as percentages (0–100 when within budget; above 100 when over).
+// A dimension with a zero maximum reports 0.
+//
+// Example:
+//
+//	mem, steps := fees.ExecutionBudgetUtilization(
+//		fees.ExUnits{Memory: 1_400_000, Steps: 500_000_000},
+//		fees.DefaultMaxTxExecutionUnits(),
+//	)
+//	// mem = 10, steps = 5
+func ExecutionBudgetUtilization(used, max ExUnits) (memPercent, stepsPercent float64) {
+	if max.Memory > 0 {
+		memPercent = float64(used.Memory) / float64(max.Memory) * 100
+	}
+	if max.Steps > 0 {
+		stepsPercent = float64(used.Steps) / float64(max.Steps) * 100
+	}
+	return memPercent, stepsPercent
+}
+
+// Rational is a non-negative fraction, used for protocol parameters that
+// the ledger stores as exact ratios rather than floating-point values.
+type Rational struct {
+	Numerator   uint64
+	Denominator uint64
+}
+
+// String returns the fraction as "Numerator/Denominator".
+//
+// Example:
+//
+//	fees.Rational{Numerator: 577, Denominator: 10000}.String() // "577/10000"
+func (r Rational) String() string {
+	return fmt.Sprintf("%d/%d", r.Numerator, r.Denominator)
+}
+
+// ExecutionPrices holds the per-unit Lovelace prices for Plutus execution
+// (the executionUnitPrices protocol parameter).
+//
+// Mainnet: PriceMemory 577/10000, PriceSteps 721/10000000
+type ExecutionPrices struct {
+	// PriceMemory is the Lovelace price per memory unit.
+	PriceMemory Rational
+
+	// PriceSteps is the Lovelace price per CPU step.
+	PriceSteps Rational
+}
+
+// DefaultMainnetExecutionPrices returns the mainnet executionUnitPrices as
+// of the Conway era. Always fetch live params for production use — this
+// may change via governance.
+//
+// Example:
+//
+//	prices := fees.DefaultMainnetExecutionPrices() // {577/10000, 721/10000000}
+func DefaultMainnetExecutionPrices() ExecutionPrices {
+	return ExecutionPrices{
+		PriceMemory: Rational{Numerator: 577, Denominator: 10_000},
+		PriceSteps:  Rational{Numerator: 721, Denominator: 10_000_000},
+	}
+}
+
+// String returns the prices as exact fractions.
+//
+// Example:
+//
+//	ep := fees.ExecutionPrices{
+//		PriceMemory: fees.Rational{Numerator: 577, Denominator: 10000},
+//		PriceSteps:  fees.Rational{Numerator: 721, Denominator: 10000000},
+//	}
+//	ep.String() // "ExecutionPrices{Memory: 577/10000, Steps: 721/10000000}"
+func (ep ExecutionPrices) String() string {
+	return fmt.Sprintf("ExecutionPrices{Memory: %s, Steps: %s}", ep.PriceMemory, ep.PriceSteps)
+}
+
+// ScriptDataHashBytes returns the size of the script_data_hash transaction
+// body field: a 32-byte hash plus a 2-byte CBOR header, 34 bytes. The field
+// is mandatory in any transaction with Plutus scripts and redeemers, and
+// leaving it out is a common source of size under-estimation. The Plutus
+// estimators in this package include it.
+//
+// Example:
+//
+//	fees.ScriptDataHashBytes() // 34
+func ScriptDataHashBytes() uint64 {
+	return 34
+}
+
+// ScriptFee returns the Lovelace fee for executing Plutus scripts with the
+// given budget, using the ledger formula:
+//
+//	scriptFee = ceil(PriceMemory * Memory + PriceSteps * Steps)
+//
+// The calculation is exact; the rounding happens once, on the sum.
+// Returns an error if either price has a zero denominator or the result
+// overflows uint64.
+//
+// Example:
+//
+//	prices := fees.ExecutionPrices{
+//		PriceMemory: fees.Rational{Numerator: 577, Denominator: 10000},
+//		PriceSteps:  fees.Rational{Numerator: 721, Denominator: 10000000},
+//	}
+//	fee, err := fees.ScriptFee(fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000}, prices)
+//	// fee = ceil(57700 + 36050) = 93,750
+func ScriptFee(units ExUnits, prices ExecutionPrices) (uint64, error) {
+	if prices.PriceMemory.Denominator == 0 {
+		return 0, NewFeeError("PriceMemory denominator must be non-zero")
+	}
+	if prices.PriceSteps.Denominator == 0 {
+		return 0, NewFeeError("PriceSteps denominator must be non-zero")
+	}
+
+	mem := new(big.Rat).SetFrac(
+		new(big.Int).SetUint64(prices.PriceMemory.Numerator),
+		new(big.Int).SetUint64(prices.PriceMemory.Denominator),
+	)
+	mem.Mul(mem, new(big.Rat).SetInt(new(big.Int).SetUint64(units.Memory)))
+
+	steps := new(big.Rat).SetFrac(
+		new(big.Int).SetUint64(prices.PriceSteps.Numerator),
+		new(big.Int).SetUint64(prices.PriceSteps.Denominator),
+	)
+	steps.Mul(steps, new(big.Rat).SetInt(new(big.Int).SetUint64(units.Steps)))
+
+	total := mem.Add(mem, steps)
+
+	// ceil(n/d) = (n + d - 1) / d for non-negative n and positive d.
+	n, d := total.Num(), total.Denom()
+	fee := new(big.Int).Add(n, d)
+	fee.Sub(fee, big.NewInt(1))
+	fee.Quo(fee, d)
+	if !fee.IsUint64() {
+		return 0, NewFeeError("script fee overflows uint64")
+	}
+	return fee.Uint64(), nil
+}
+
+// CollateralReturnByteEstimate estimates how many bytes a collateral return
+// output adds to a transaction body: an ADA-only output to an address of
+// addrBytes bytes, plus one byte for its body map key.
+//
+// Example:
+//
+//	n := fees.CollateralReturnByteEstimate(57) // 77
+func CollateralReturnByteEstimate(addrBytes uint64) uint64 {
+	const bodyKeyBytes uint64 = 1
+	return bodyKeyBytes + EstimateOutputBytes(OutputSize{AddressBytes: addrBytes})
+}
+
+// MinFeeWithCollateralReturn calculates the fee and required collateral for
+// a Plutus transaction that includes a collateral return output, so that a
+// failed script does not forfeit all of its collateral inputs.
+//
+// txSizeBytes is the size of the transaction without the collateral return
+// output; CollateralReturnByteEstimate(collateralReturnAddrBytes) is added
+// to it. The returned fee is the linear fee for that size, and
+//
+//	collateral = ceil(fee * CollateralPercentage / 100)
+//
+// The ledger applies the percentage to the whole fee, script execution
+// included; use MinFeeWithCollateralReturnAndScripts to price the
+// execution budget as well.
+//
+// The collateral inputs must hold collateral + collateralReturnLovelace,
+// and collateralReturnLovelace must meet the return output's minUTxO.
+// Returns a *ParamError if p.CollateralPercentage is zero, and a *FeeError
+// if the fee or collateral overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, collateral, err := fees.MinFeeWithCollateralReturn(p, 1200, 57, 5_000_000)
+func MinFeeWithCollateralReturn(p ProtocolParams, txSizeBytes, collateralReturnAddrBytes uint64, collateralReturnLovelace uint64) (fee uint64, collateral uint64, err error) {
+	if err := p.Validate(); err != nil {
+		return 0, 0, err
+	}
+	return minFeeWithCollateralReturn(p, txSizeBytes, collateralReturnAddrBytes, collateralReturnLovelace, 0)
+}
+
+// MinFeeWithCollateralReturnAndScripts is MinFeeWithCollateralReturn for a
+// transaction that executes exUnits at prices: the returned fee adds the
+// ScriptFee to the linear fee, and the collateral percentage applies to
+// that whole fee.
+//
+// Returns the errors of MinFeeWithCollateralReturn, and a *FeeError if
+// prices has a zero denominator.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, collateral, err := fees.MinFeeWithCollateralReturnAndScripts(p, 1200, 57, 5_000_000,
+//		fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000},
+//		fees.DefaultMainnetExecutionPrices())
+func MinFeeWithCollateralReturnAndScripts(p ProtocolParams, txSizeBytes, collateralReturnAddrBytes, collateralReturnLovelace uint64, exUnits ExUnits, prices ExecutionPrices) (fee uint64, collateral uint64, err error) {
+	if err := p.Validate(); err != nil {
+		return 0, 0, err
+	}
+	scriptFee, err := ScriptFee(exUnits, prices)
+	if err != nil {
+		return 0, 0, err
+	}
+	return minFeeWithCollateralReturn(p, txSizeBytes, collateralReturnAddrBytes, collateralReturnLovelace, scriptFee)
+}
+
+// minFeeWithCollateralReturn implements MinFeeWithCollateralReturn for an
+// already validated p, adding scriptFee to the linear fee before the
+// collateral is computed.
+func minFeeWithCollateralReturn(p ProtocolParams, txSizeBytes, collateralReturnAddrBytes, collateralReturnLovelace, scriptFee uint64) (fee uint64, collateral uint64, err error) {
+	if p.CollateralPercentage == 0 {
+		return 0, 0, &ParamError{Field: "CollateralPercentage", Message: "must be non-zero for collateral calculations"}
+	}
+
+	returnBytes := CollateralReturnByteEstimate(collateralReturnAddrBytes)
+	ok, minADA, err := IsAboveMinUTxO(p, collateralReturnLovelace, OutputSize{AddressBytes: collateralReturnAddrBytes})
+	if err != nil {
+		return 0, 0, err
+	}
+	if !ok {
+		return 0, 0, newMinUTxOError(ErrCodeBelowMinUTxO, ErrBelowMinUTxO, fmt.Sprintf("collateral return of %d Lovelace is below minUTxO %d", collateralReturnLovelace, minADA))
+	}
+
+	fee, err = minFee(p, txSizeBytes+returnBytes)
+	if err != nil {
+		return 0, 0, err
+	}
+	if fee, err = AddLovelace(fee, scriptFee); err != nil {
+		return 0, 0, err
+	}
+
+	// ceil(fee * CollateralPercentage / 100) in 128 bits; the quotient fits
+	// in uint64 only if the high word is below the divisor.
+	hi, lo := bits.Mul64(fee, p.CollateralPercentage)
+	lo, carry := bits.Add64(lo, 99, 0)
+	hi += carry
+	if hi >= 100 {
+		return 0, 0, NewFeeError("collateral overflows uint64")
+	}
+	collateral, _ = bits.Div64(hi, lo, 100)
+	return fee, collateral, nil
+}
@@ -0,0 +1,223 @@
+package fees_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestExUnitsString(t *testing.T) {
+	u := fees.ExUnits{Memory: 1000, Steps: 5000000}
+
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"String", u.String(), "ExUnits{Memory: 1,000, Steps: 5,000,000}"},
+		{"%v", fmt.Sprintf("%v", u), "ExUnits{Memory: 1,000, Steps: 5,000,000}"},
+		{"%s", fmt.Sprintf("%s", u), "ExUnits{Memory: 1,000, Steps: 5,000,000}"},
+		{"%+v", fmt.Sprintf("%+v", u), "ExUnits{Memory: 1000, Steps: 5000000}"},
+		{"zero", fees.ExUnits{}.String(), "ExUnits{Memory: 0, Steps: 0}"},
+		{"small", fees.ExUnits{Memory: 999, Steps: 100}.String(), "ExUnits{Memory: 999, Steps: 100}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.got != tc.want {
+				t.Errorf("got %q, want %q", tc.got, tc.want)
+			}
+		})
+	}
+}
+
+func TestExecutionPricesString(t *testing.T) {
+	ep := fees.ExecutionPrices{
+		PriceMemory: fees.Rational{Numerator: 577, Denominator: 10000},
+		PriceSteps:  fees.Rational{Numerator: 721, Denominator: 10000000},
+	}
+	want := "ExecutionPrices{Memory: 577/10000, Steps: 721/10000000}"
+	if got := ep.String(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got := fmt.Sprintf("%v", ep); got != want {
+		t.Errorf("%%v: got %q, want %q", got, want)
+	}
+}
+
+func TestScriptFee(t *testing.T) {
+	mainnet := fees.ExecutionPrices{
+		PriceMemory: fees.Rational{Numerator: 577, Denominator: 10000},
+		PriceSteps:  fees.Rational{Numerator: 721, Denominator: 10000000},
+	}
+
+	tests := []struct {
+		name    string
+		units   fees.ExUnits
+		prices  fees.ExecutionPrices
+		want    uint64
+		wantErr bool
+	}{
+		{"zero budget", fees.ExUnits{}, mainnet, 0, false},
+		{"exact", fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000}, mainnet, 93_750, false},
+		// 0.0577 + 0.0000721 = 0.0577721, rounded up once.
+		{"rounds up on the sum", fees.ExUnits{Memory: 1, Steps: 1}, mainnet, 1, false},
+		{"zero memory denominator", fees.ExUnits{Memory: 1}, fees.ExecutionPrices{PriceSteps: mainnet.PriceSteps}, 0, true},
+		{"zero steps denominator", fees.ExUnits{Memory: 1}, fees.ExecutionPrices{PriceMemory: mainnet.PriceMemory}, 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.ScriptFee(tc.units, tc.prices)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMinFeeWithCollateralReturn(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	fee, collateral, err := fees.MinFeeWithCollateralReturn(p, 1200, 57, 5_000_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinFee(p, 1200+fees.CollateralReturnByteEstimate(57)); fee != want {
+		t.Errorf("fee = %d, want %d", fee, want)
+	}
+	if want := (fee*150 + 99) / 100; collateral != want {
+		t.Errorf("collateral = %d, want %d", collateral, want)
+	}
+
+	noPct := p
+	noPct.CollateralPercentage = 0
+	hugePct := p
+	hugePct.CollateralPercentage = math.MaxUint64
+
+	tests := []struct {
+		name     string
+		p        fees.ProtocolParams
+		txSize   uint64
+		lovelace uint64
+	}{
+		{"zero params", fees.ProtocolParams{}, 1200, 5_000_000},
+		{"no collateral percentage", noPct, 1200, 5_000_000},
+		{"return below minUTxO", p, 1200, 100},
+		{"tx too large", p, 16_384, 5_000_000},
+		{"collateral overflow", hugePct, 1200, 5_000_000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := fees.MinFeeWithCollateralReturn(tc.p, tc.txSize, 57, tc.lovelace); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestMinFeeWithCollateralReturnAndScripts(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	units := fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000}
+	prices := fees.DefaultMainnetExecutionPrices()
+
+	fee, collateral, err := fees.MinFeeWithCollateralReturnAndScripts(p, 1200, 57, 5_000_000, units, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The percentage applies to the whole fee, script execution included.
+	linearFee, _ := fees.MinFee(p, 1200+fees.CollateralReturnByteEstimate(57))
+	wantFee := linearFee + 93_750
+	if fee != wantFee {
+		t.Errorf("fee = %d, want %d", fee, wantFee)
+	}
+	if want := (wantFee*150 + 99) / 100; collateral != want {
+		t.Errorf("collateral = %d, want %d", collateral, want)
+	}
+	if collateral < fee {
+		t.Errorf("collateral %d should be at least the fee %d", collateral, fee)
+	}
+
+	noPct := p
+	noPct.CollateralPercentage = 0
+	hugePct := p
+	hugePct.CollateralPercentage = math.MaxUint64
+
+	tests := []struct {
+		name     string
+		p        fees.ProtocolParams
+		txSize   uint64
+		lovelace uint64
+		prices   fees.ExecutionPrices
+	}{
+		{"zero params", fees.ProtocolParams{}, 1200, 5_000_000, prices},
+		{"no collateral percentage", noPct, 1200, 5_000_000, prices},
+		{"return below minUTxO", p, 1200, 100, prices},
+		{"tx too large", p, 16_384, 5_000_000, prices},
+		{"zero price denominator", p, 1200, 5_000_000, fees.ExecutionPrices{}},
+		{"collateral overflow", hugePct, 1200, 5_000_000, prices},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := fees.MinFeeWithCollateralReturnAndScripts(tc.p, tc.txSize, 57, tc.lovelace, units, tc.prices); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestExecutionBudgetUtilization(t *testing.T) {
+	maxTx := fees.DefaultMaxTxExecutionUnits()
+
+	tests := []struct {
+		name      string
+		used      fees.ExUnits
+		max       fees.ExUnits
+		wantMem   float64
+		wantSteps float64
+	}{
+		{"nothing used", fees.ExUnits{}, maxTx, 0, 0},
+		{"ten and five percent", fees.ExUnits{Memory: 1_400_000, Steps: 500_000_000}, maxTx, 10, 5},
+		{"full budget", maxTx, maxTx, 100, 100},
+		{"over budget", fees.ExUnits{Memory: 28_000_000, Steps: 10_000_000_000}, maxTx, 200, 100},
+		{"zero max", fees.ExUnits{Memory: 1, Steps: 1}, fees.ExUnits{}, 0, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mem, steps := fees.ExecutionBudgetUtilization(tc.used, tc.max)
+			if mem != tc.wantMem || steps != tc.wantSteps {
+				t.Errorf("got (%v, %v), want (%v, %v)", mem, steps, tc.wantMem, tc.wantSteps)
+			}
+		})
+	}
+}
+
+func TestDefaultExecutionBudgets(t *testing.T) {
+	maxTx := fees.DefaultMaxTxExecutionUnits()
+	maxBlock := fees.DefaultMaxBlockExecutionUnits()
+	if maxTx.Memory > maxBlock.Memory || maxTx.Steps > maxBlock.Steps {
+		t.Errorf("tx budget %v exceeds block budget %v", maxTx, maxBlock)
+	}
+
+	// A typical DEX swap validator stays well within the transaction budget.
+	typical := fees.ExUnits{Memory: 2_500_000, Steps: 800_000_000}
+	mem, steps := fees.ExecutionBudgetUtilization(typical, maxTx)
+	if mem > 100 || steps > 100 {
+		t.Errorf("typical script uses %.1f%% memory, %.1f%% steps; want <= 100%%", mem, steps)
+	}
+}
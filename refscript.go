@@ -0,0 +1,122 @@
+package fees
+
+import "math/big"
+
+// Conway reference script fee tiers: the per-byte price is multiplied by
+// refScriptTierMultiplier for each further refScriptTierBytes of scripts.
+const refScriptTierBytes uint64 = 25_600
+
+// refScriptTierMultiplier is 1.2, as a fraction.
+var refScriptTierMultiplier = big.NewRat(6, 5)
+
+// refScriptFeeLimit is 2^64, the smallest fee that overflows uint64.
+var refScriptFeeLimit = new(big.Rat).SetInt(new(big.Int).Lsh(big.NewInt(1), 64))
+
+// RefScriptFee returns the Conway surcharge, in Lovelace, for a
+// transaction that uses totalRefScriptBytes of reference scripts. The
+// first 25,600 bytes cost p.MinFeeRefScriptCostPerByte each, and the price
+// rises by a factor of 1.2 for each further 25,600-byte tier:
+//
+//	fee = floor(Σ tierBytes × MinFeeRefScriptCostPerByte × 1.2^tier)
+//
+// The calculation is exact; the rounding happens once, on the sum.
+// Returns a *ParamError if p is invalid, or if totalRefScriptBytes is
+// non-zero and p.MinFeeRefScriptCostPerByte is zero, and a *FeeError if
+// the fee overflows uint64. The exponential price reaches that limit
+// within a few hundred tiers, so the work is bounded for any size.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.RefScriptFee(p, 10_000) // 150,000
+func RefScriptFee(p ProtocolParams, totalRefScriptBytes uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return refScriptFee(p, totalRefScriptBytes)
+}
+
+// refScriptFee is RefScriptFee without parameter validation.
+func refScriptFee(p ProtocolParams, totalRefScriptBytes uint64) (uint64, error) {
+	if totalRefScriptBytes == 0 {
+		return 0, nil
+	}
+	if p.MinFeeRefScriptCostPerByte == 0 {
+		return 0, &ParamError{Field: "MinFeeRefScriptCostPerByte", Message: "must be non-zero for reference script fees"}
+	}
+
+	total := new(big.Rat)
+	price := new(big.Rat).SetInt(new(big.Int).SetUint64(p.MinFeeRefScriptCostPerByte))
+	for remaining := totalRefScriptBytes; remaining > 0; {
+		n := min(remaining, refScriptTierBytes)
+		tier := new(big.Rat).SetInt(new(big.Int).SetUint64(n))
+		total.Add(total, tier.Mul(tier, price))
+		if total.Cmp(refScriptFeeLimit) >= 0 {
+			return 0, NewFeeError("reference script fee overflows uint64")
+		}
+		price.Mul(price, refScriptTierMultiplier)
+		remaining -= n
+	}
+
+	return new(big.Int).Quo(total.Num(), total.Denom()).Uint64(), nil
+}
+
+// FeeBreakdown itemises the components of a transaction's minimum fee.
+type FeeBreakdown struct {
+	// LinearFee is MinFeeA * txSizeBytes + MinFeeB.
+	LinearFee uint64
+
+	// ScriptFee is the Plutus execution fee for the declared ExUnits.
+	ScriptFee uint64
+
+	// RefScriptFee is the Conway surcharge for reference script bytes.
+	RefScriptFee uint64
+}
+
+// Total returns the sum of the components, the transaction's minimum fee.
+// Breakdowns returned by this package are checked not to overflow.
+//
+// Example:
+//
+//	b, err := fees.RefScriptTransactionFee(p, 1_200, units, prices, 10_000)
+//	fee := b.Total()
+func (b FeeBreakdown) Total() uint64 {
+	return b.LinearFee + b.ScriptFee + b.RefScriptFee
+}
+
+// RefScriptTransactionFee computes the three fee components of a
+// transaction that runs Plutus scripts supplied by reference: the linear
+// fee for txSizeBytes, the ScriptFee for exUnits at prices, and the
+// RefScriptFee for totalRefScriptBytes.
+//
+// Returns an error if p is invalid, txSizeBytes is out of range, prices
+// has a zero denominator, p.MinFeeRefScriptCostPerByte is zero while
+// totalRefScriptBytes is not, or the total overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	b, err := fees.RefScriptTransactionFee(p, 1_200,
+//		fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000},
+//		fees.DefaultMainnetExecutionPrices(), 10_000)
+//	// b.LinearFee = 208,181, b.ScriptFee = 93,750, b.RefScriptFee = 150,000
+func RefScriptTransactionFee(p ProtocolParams, txSizeBytes uint64, exUnits ExUnits, prices ExecutionPrices, totalRefScriptBytes uint64) (FeeBreakdown, error) {
+	if err := p.Validate(); err != nil {
+		return FeeBreakdown{}, err
+	}
+	var b FeeBreakdown
+	var err error
+	if b.LinearFee, err = minFee(p, txSizeBytes); err != nil {
+		return FeeBreakdown{}, err
+	}
+	if b.ScriptFee, err = ScriptFee(exUnits, prices); err != nil {
+		return FeeBreakdown{}, err
+	}
+	if b.RefScriptFee, err = refScriptFee(p, totalRefScriptBytes); err != nil {
+		return FeeBreakdown{}, err
+	}
+	if _, err := SumLovelace([]uint64{b.LinearFee, b.ScriptFee, b.RefScriptFee}); err != nil {
+		return FeeBreakdown{}, err
+	}
+	return b, nil
+}
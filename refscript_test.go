@@ -0,0 +1,83 @@
+package fees_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestRefScriptFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name  string
+		bytes uint64
+		want  uint64
+	}{
+		{"none", 0, 0},
+		{"first tier", 10_000, 150_000},
+		{"full first tier", 25_600, 384_000},
+		{"into second tier", 30_000, 384_000 + 4_400*18},
+		{"into third tier", 51_201, 384_000 + 25_600*18 + 21}, // 21.6 rounded down
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.RefScriptFee(p, tc.bytes)
+			if err != nil || got != tc.want {
+				t.Errorf("RefScriptFee(%d) = %d, %v; want %d", tc.bytes, got, err, tc.want)
+			}
+		})
+	}
+
+	// The tier loop must stop at the overflow rather than walk every tier.
+	var fe *fees.FeeError
+	if _, err := fees.RefScriptFee(p, math.MaxUint64); !errors.As(err, &fe) {
+		t.Errorf("RefScriptFee(MaxUint64) err = %v, want *FeeError", err)
+	}
+	opts := fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 1}.WithRefScriptBytes(math.MaxUint64)
+	if _, err := fees.EstimateFeeWithOptions(p, opts); !errors.As(err, &fe) {
+		t.Errorf("EstimateFeeWithOptions(RefScriptBytes: MaxUint64) err = %v, want *FeeError", err)
+	}
+
+	p.MinFeeRefScriptCostPerByte = 0
+	var pe *fees.ParamError
+	if _, err := fees.RefScriptFee(p, 1_000); !errors.As(err, &pe) {
+		t.Errorf("err = %v, want *ParamError", err)
+	}
+	if got, err := fees.RefScriptFee(p, 0); err != nil || got != 0 {
+		t.Errorf("RefScriptFee(0) with zero price = %d, %v; want 0, nil", got, err)
+	}
+}
+
+func TestRefScriptTransactionFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	units := fees.ExUnits{Memory: 1_000_000, Steps: 500_000_000}
+	prices := fees.DefaultMainnetExecutionPrices()
+
+	b, err := fees.RefScriptTransactionFee(p, 1_200, units, prices, 10_000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	minFee, _ := fees.MinFee(p, 1_200)
+	scriptFee, _ := fees.ScriptFee(units, prices)
+	refFee, _ := fees.RefScriptFee(p, 10_000)
+	if b.LinearFee != minFee || b.ScriptFee != scriptFee || b.RefScriptFee != refFee {
+		t.Errorf("breakdown = %+v, want {%d %d %d}", b, minFee, scriptFee, refFee)
+	}
+	if want := minFee + scriptFee + refFee; b.Total() != want {
+		t.Errorf("Total() = %d, want %d", b.Total(), want)
+	}
+	if b.Total() != 208_181+93_750+150_000 {
+		t.Errorf("Total() = %d, want 451931", b.Total())
+	}
+
+	if _, err := fees.RefScriptTransactionFee(p, 20_000, units, prices, 10_000); !errors.Is(err, fees.ErrTxTooLarge) {
+		t.Errorf("oversized tx err = %v, want ErrTxTooLarge", err)
+	}
+	if _, err := fees.RefScriptTransactionFee(p, 1_200, units, fees.ExecutionPrices{}, 10_000); err == nil {
+		t.Error("expected error for zero price denominators")
+	}
+}
@@ -0,0 +1,77 @@
+package fees
+
+import "fmt"
+
+// ScriptContextVersion identifies the Plutus language version whose script
+// context (the transaction summary passed to a validator) is being sized.
+type ScriptContextVersion uint8
+
+const (
+	// ScriptContextV1 is the Plutus V1 (Alonzo) TxInfo.
+	ScriptContextV1 ScriptContextVersion = iota
+
+	// ScriptContextV2 is the Plutus V2 (Babbage) TxInfo, which adds
+	// reference inputs, the redeemer map, and inline datums.
+	ScriptContextV2
+
+	// ScriptContextV3 is the Plutus V3 (Conway) context, which adds
+	// governance data: votes, proposals, and treasury fields. Conway
+	// certificates also carry their deposits.
+	ScriptContextV3
+)
+
+// String returns the version's name, e.g. "PlutusV3".
+func (v ScriptContextVersion) String() string {
+	switch v {
+	case ScriptContextV1:
+		return "PlutusV1"
+	case ScriptContextV2:
+		return "PlutusV2"
+	case ScriptContextV3:
+		return "PlutusV3"
+	default:
+		return fmt.Sprintf("ScriptContextVersion(%d)", uint8(v))
+	}
+}
+
+// scriptContextSizes holds the byte model for one script context version.
+type scriptContextSizes struct {
+	base, input, output, cert, withdrawal uint64
+}
+
+// scriptContextModel returns the byte model for v. Unknown versions use
+// the V3 model, the largest.
+func scriptContextModel(v ScriptContextVersion) scriptContextSizes {
+	switch v {
+	case ScriptContextV1:
+		return scriptContextSizes{base: 200, input: 120, output: 80, cert: 40, withdrawal: 40}
+	case ScriptContextV2:
+		return scriptContextSizes{base: 250, input: 120, output: 80, cert: 40, withdrawal: 40}
+	default:
+		return scriptContextSizes{base: 350, input: 120, output: 80, cert: 60, withdrawal: 40}
+	}
+}
+
+// EstimateScriptContextBytes estimates the serialized size of the script
+// context a validator of the given version receives for a transaction with
+// the given numbers of inputs, outputs, certificates, and withdrawals:
+//
+//	          base  input  output  cert  withdrawal
+//	V1:       200   120    80      40    40
+//	V2:       250   120    80      40    40
+//	V3:       350   120    80      60    40
+//
+// Each input is counted with its resolved output. The script context is
+// built by the node and is not part of the transaction bytes, so it does
+// not change MinFee; it drives the memory a script spends decoding it, and
+// therefore the ExUnits budget to declare. Unknown versions are sized as
+// V3.
+//
+// Example:
+//
+//	n := fees.EstimateScriptContextBytes(fees.ScriptContextV3, 2, 2, 1, 0)
+//	// 350 + 2*120 + 2*80 + 60 = 810
+func EstimateScriptContextBytes(version ScriptContextVersion, numInputs, numOutputs, numCerts, numWithdrawals uint64) uint64 {
+	m := scriptContextModel(version)
+	return m.base + m.input*numInputs + m.output*numOutputs + m.cert*numCerts + m.withdrawal*numWithdrawals
+}
@@ -0,0 +1,49 @@
+package fees_test
+
+import (
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestEstimateScriptContextBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		version fees.ScriptContextVersion
+		in, out uint64
+		certs   uint64
+		wdrls   uint64
+		want    uint64
+	}{
+		{"V1 empty", fees.ScriptContextV1, 0, 0, 0, 0, 200},
+		{"V1", fees.ScriptContextV1, 2, 2, 1, 1, 200 + 240 + 160 + 40 + 40},
+		{"V2", fees.ScriptContextV2, 2, 2, 1, 1, 250 + 240 + 160 + 40 + 40},
+		{"V3", fees.ScriptContextV3, 2, 2, 1, 0, 810},
+		{"unknown sized as V3", fees.ScriptContextVersion(9), 2, 2, 1, 0, 810},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := fees.EstimateScriptContextBytes(tc.version, tc.in, tc.out, tc.certs, tc.wdrls)
+			if got != tc.want {
+				t.Errorf("EstimateScriptContextBytes(%v) = %d, want %d", tc.version, got, tc.want)
+			}
+		})
+	}
+
+	// Each version's context is at least as large as the one before it.
+	for v := fees.ScriptContextV1; v < fees.ScriptContextV3; v++ {
+		if a, b := fees.EstimateScriptContextBytes(v, 3, 3, 2, 2), fees.EstimateScriptContextBytes(v+1, 3, 3, 2, 2); a > b {
+			t.Errorf("%v context %d is larger than %v context %d", v, a, v+1, b)
+		}
+	}
+}
+
+func TestScriptContextVersionString(t *testing.T) {
+	if got := fees.ScriptContextV3.String(); got != "PlutusV3" {
+		t.Errorf("ScriptContextV3.String() = %q", got)
+	}
+	if got := fees.ScriptContextVersion(7).String(); got != "ScriptContextVersion(7)" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,39 @@
+package fees
+
+import "time"
+
+// ProtocolParamsSnapshot records when a set of ProtocolParams was fetched,
+// so that long-running services can tell when to refresh them. Protocol
+// parameters change through governance; cached values should not be used
+// indefinitely.
+type ProtocolParamsSnapshot struct {
+	// Params are the fetched protocol parameters.
+	Params ProtocolParams
+
+	// FetchedAt is when Params were fetched from the chain or an API.
+	FetchedAt time.Time
+}
+
+// NewSnapshot returns a snapshot of p fetched now.
+//
+// Example:
+//
+//	snap := fees.NewSnapshot(paramsFromBlockfrost)
+func NewSnapshot(p ProtocolParams) ProtocolParamsSnapshot {
+	return ProtocolParamsSnapshot{Params: p, FetchedAt: time.Now()}
+}
+
+// IsStale reports whether the snapshot is older than maxAge. A snapshot
+// with a zero FetchedAt is always stale.
+//
+// Example:
+//
+//	if snap.IsStale(10 * time.Minute) {
+//		snap = fees.NewSnapshot(refetchParams())
+//	}
+func (s ProtocolParamsSnapshot) IsStale(maxAge time.Duration) bool {
+	if s.FetchedAt.IsZero() {
+		return true
+	}
+	return time.Since(s.FetchedAt) > maxAge
+}
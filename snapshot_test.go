@@ -0,0 +1,37 @@
+package fees_test
+
+import (
+	"testing"
+	"time"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestProtocolParamsSnapshotIsStale(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	fresh := fees.NewSnapshot(p)
+	if fresh.Params != p {
+		t.Errorf("NewSnapshot Params = %+v, want %+v", fresh.Params, p)
+	}
+
+	tests := []struct {
+		name   string
+		s      fees.ProtocolParamsSnapshot
+		maxAge time.Duration
+		want   bool
+	}{
+		{"just fetched", fresh, time.Hour, false},
+		{"older than maxAge", fees.ProtocolParamsSnapshot{Params: p, FetchedAt: time.Now().Add(-2 * time.Hour)}, time.Hour, true},
+		{"within maxAge", fees.ProtocolParamsSnapshot{Params: p, FetchedAt: time.Now().Add(-time.Minute)}, time.Hour, false},
+		{"never fetched", fees.ProtocolParamsSnapshot{Params: p}, 24 * time.Hour, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.s.IsStale(tc.maxAge); got != tc.want {
+				t.Errorf("IsStale(%v) = %v, want %v", tc.maxAge, got, tc.want)
+			}
+		})
+	}
+}
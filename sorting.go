@@ -0,0 +1,110 @@
+package fees
+
+import "sort"
+
+// MinUTxOComparatorFunc compares two outputs by minUTxO cost, returning a
+// negative number when a is cheaper than b, zero when they cost the same,
+// and a positive number when a is more expensive. Its signature matches
+// slices.SortFunc.
+type MinUTxOComparatorFunc func(a, b OutputSize) int
+
+// NewMinUTxOComparator returns a MinUTxOComparatorFunc that orders outputs
+// by their MinUTxO under p. The params are validated once, up front.
+//
+// Example:
+//
+//	cmp, err := fees.NewMinUTxOComparator(fees.DefaultMainnetParams())
+//	if err != nil {
+//		return err
+//	}
+//	slices.SortStableFunc(outputs, cmp)
+func NewMinUTxOComparator(p ProtocolParams) (MinUTxOComparatorFunc, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return func(a, b OutputSize) int {
+		ca, cb := minUTxOCost(p, a), minUTxOCost(p, b)
+		switch {
+		case ca < cb:
+			return -1
+		case ca > cb:
+			return 1
+		default:
+			return 0
+		}
+	}, nil
+}
+
+// minUTxOCost is MinUTxO for params that have already been validated.
+// EstimateOutputBytes is never zero, so it cannot fail.
+func minUTxOCost(p ProtocolParams, out OutputSize) uint64 {
+	return TotalUTxOBytes(EstimateOutputBytes(out)) * p.CoinsPerUTxOByte
+}
+
+// SortOutputsByMinUTxO sorts outputs in place from cheapest to most
+// expensive minUTxO. The sort is stable: outputs of equal cost keep their
+// relative order.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	err := fees.SortOutputsByMinUTxO(p, outputs)
+func SortOutputsByMinUTxO(p ProtocolParams, outputs []OutputSize) error {
+	cmp, err := NewMinUTxOComparator(p)
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(outputs, func(i, j int) bool {
+		return cmp(outputs[i], outputs[j]) < 0
+	})
+	return nil
+}
+
+// RankOutputsByCost returns the permutation that orders outputs from most
+// to least expensive minUTxO: the result's first element is the index of
+// the most expensive output. The ranking is stable, so outputs of equal
+// cost keep their relative order. outputs itself is not modified.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	order, err := fees.RankOutputsByCost(p, outputs)
+//	for _, i := range order {
+//		allocate(outputs[i])
+//	}
+func RankOutputsByCost(p ProtocolParams, outputs []OutputSize) ([]int, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	costs := make([]uint64, len(outputs))
+	order := make([]int, len(outputs))
+	for i, out := range outputs {
+		costs[i] = minUTxOCost(p, out)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return costs[order[i]] > costs[order[j]]
+	})
+	return order, nil
+}
+
+// SortOutputsByMinUTxOCost returns a copy of outputs ordered from most to
+// least expensive minUTxO, the order in which coin selection should
+// allocate ADA. The sort is stable and outputs is not modified; use
+// SortOutputsByMinUTxO to sort cheapest first in place.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	sorted, err := fees.SortOutputsByMinUTxOCost(p, outputs)
+func SortOutputsByMinUTxOCost(p ProtocolParams, outputs []OutputSize) ([]OutputSize, error) {
+	order, err := RankOutputsByCost(p, outputs)
+	if err != nil {
+		return nil, err
+	}
+	sorted := make([]OutputSize, len(outputs))
+	for i, idx := range order {
+		sorted[i] = outputs[idx]
+	}
+	return sorted, nil
+}
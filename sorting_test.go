@@ -0,0 +1,101 @@
+package fees_test
+
+import (
+	"slices"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestNewMinUTxOComparator(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	cmp, err := fees.NewMinUTxOComparator(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adaOnly := fees.OutputSize{AddressBytes: 57}
+	nft := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 32}
+
+	if cmp(adaOnly, nft) >= 0 {
+		t.Error("ADA-only output should compare below NFT output")
+	}
+	if cmp(nft, adaOnly) <= 0 {
+		t.Error("NFT output should compare above ADA-only output")
+	}
+	if cmp(nft, nft) != 0 {
+		t.Error("equal outputs should compare equal")
+	}
+
+	if _, err := fees.NewMinUTxOComparator(fees.ProtocolParams{}); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
+
+func TestSortOutputsByMinUTxO(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	// Equal-cost outputs that differ in a field the cost ignores, so the
+	// test can observe their relative order.
+	equalA := fees.OutputSize{AddressBytes: 57, HasInlineDatum: false, InlineDatumBytes: 1}
+	equalB := fees.OutputSize{AddressBytes: 57, HasInlineDatum: false, InlineDatumBytes: 2}
+	big := fees.OutputSize{AddressBytes: 57, NumPolicies: 2, NumAssets: 4, TotalAssetNameBytes: 64}
+	small := fees.OutputSize{AddressBytes: 29}
+
+	outputs := []fees.OutputSize{big, equalA, small, equalB}
+	if err := fees.SortOutputsByMinUTxO(p, outputs); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []fees.OutputSize{small, equalA, equalB, big}
+	for i := range want {
+		if outputs[i] != want[i] {
+			t.Errorf("outputs[%d] = %+v, want %+v", i, outputs[i], want[i])
+		}
+	}
+
+	if err := fees.SortOutputsByMinUTxO(fees.ProtocolParams{}, outputs); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
+
+func TestSortOutputsByMinUTxOCost(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	// a and c cost the same; d is ADA-only with the same address as a, so
+	// it is the cheapest. Equal-cost outputs keep their input order.
+	a := fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 8}
+	b := fees.OutputSize{AddressBytes: 57, NumPolicies: 2, NumAssets: 5, TotalAssetNameBytes: 40}
+	c := fees.OutputSize{AddressBytes: 29, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 36}
+	d := fees.OutputSize{AddressBytes: 57}
+	outputs := []fees.OutputSize{a, b, c, d}
+	original := slices.Clone(outputs)
+
+	order, err := fees.RankOutputsByCost(p, outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 0, 2, 3}; !slices.Equal(order, want) {
+		t.Errorf("RankOutputsByCost = %v, want %v", order, want)
+	}
+
+	sorted, err := fees.SortOutputsByMinUTxOCost(p, outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []fees.OutputSize{b, a, c, d}; !slices.Equal(sorted, want) {
+		t.Errorf("SortOutputsByMinUTxOCost = %v, want %v", sorted, want)
+	}
+	if !slices.Equal(outputs, original) {
+		t.Error("input slice was modified")
+	}
+
+	// Swapping the equal-cost outputs swaps them in the result too.
+	order, _ = fees.RankOutputsByCost(p, []fees.OutputSize{c, b, a, d})
+	if want := []int{1, 0, 2, 3}; !slices.Equal(order, want) {
+		t.Errorf("RankOutputsByCost with swapped ties = %v, want %v", order, want)
+	}
+
+	if _, err := fees.SortOutputsByMinUTxOCost(fees.ProtocolParams{}, outputs); err == nil {
+		t.Error("expected error for invalid params")
+	}
+}
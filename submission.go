@@ -0,0 +1,207 @@
+package fees
+
+import (
+	"fmt"
+	"math"
+)
+
+// TransactionViabilityReport collects the results of the pre-submission
+// checks performed by IsTransactionViable.
+type TransactionViabilityReport struct {
+	// SizeOK is true if the transaction size is non-zero and within MaxTxSize.
+	SizeOK bool
+
+	// FeeOK is true if the proposed fee covers MinFee for the transaction
+	// size. It is always false when SizeOK is false.
+	FeeOK bool
+
+	// OutputsOK[i] is true if outputLovelaces[i] meets the minUTxO of outputs[i].
+	OutputsOK []bool
+
+	// Errors describes every failed check, in the order the checks ran.
+	Errors []error
+}
+
+// Viable reports whether every check in the report passed.
+func (r TransactionViabilityReport) Viable() bool {
+	return len(r.Errors) == 0
+}
+
+// IsTransactionViable runs every pre-submission check in one call: the
+// transaction size is within MaxTxSize, proposedFee covers the minimum fee,
+// and each output carries at least its minUTxO. All checks run regardless
+// of earlier failures, so the report describes every problem at once.
+//
+// outputs and outputLovelaces are parallel slices. The returned error is
+// non-nil only when the checks could not be run at all: invalid params or
+// mismatched slice lengths.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	r, err := fees.IsTransactionViable(p, 420, 180_000,
+//		[]fees.OutputSize{{AddressBytes: 57}},
+//		[]uint64{2_000_000},
+//	)
+//	if err == nil && !r.Viable() {
+//		for _, e := range r.Errors {
+//			log.Println(e)
+//		}
+//	}
+func IsTransactionViable(p ProtocolParams, txSizeBytes, proposedFee uint64, outputs []OutputSize, outputLovelaces []uint64) (TransactionViabilityReport, error) {
+	var r TransactionViabilityReport
+	if err := p.Validate(); err != nil {
+		return r, err
+	}
+	if len(outputs) != len(outputLovelaces) {
+		return r, NewFeeError(fmt.Sprintf("got %d outputs but %d output amounts", len(outputs), len(outputLovelaces)))
+	}
+
+	required, err := minFee(p, txSizeBytes)
+	if err != nil {
+		r.Errors = append(r.Errors, err)
+	} else {
+		r.SizeOK = true
+		if proposedFee >= required {
+			r.FeeOK = true
+		} else {
+			r.Errors = append(r.Errors, NewFeeError(fmt.Sprintf("proposed fee %d is below minimum fee %d", proposedFee, required)))
+		}
+	}
+
+	r.OutputsOK = make([]bool, len(outputs))
+	for i, out := range outputs {
+		ok, minADA, err := IsAboveMinUTxO(p, outputLovelaces[i], out)
+		if err != nil {
+			r.Errors = append(r.Errors, err)
+			continue
+		}
+		if !ok {
+			r.Errors = append(r.Errors, newMinUTxOError(ErrCodeBelowMinUTxO, ErrBelowMinUTxO, fmt.Sprintf("output %d: %d Lovelace is below minUTxO %d", i, outputLovelaces[i], minADA)))
+			continue
+		}
+		r.OutputsOK[i] = true
+	}
+
+	return r, nil
+}
+
+// TotalTransactionADACost calculates what a transaction costs the sender:
+//
+//	total = fee + locked + sum(deposits)
+//
+// where fee is MinFee(p, txSizeBytes) and locked is the sum of sentAmounts,
+// the ADA placed in outputs to other parties. outputs and sentAmounts are
+// parallel slices; each sent amount must meet its output's minUTxO.
+// deposits are protocol deposits paid by the transaction (e.g. a stake key
+// registration deposit) and may be nil.
+//
+// minChange is not the transaction's change, which depends on the inputs.
+// It is the minUTxO of an ADA-only change output to a base address:
+// whatever the wallet's inputs hold beyond total comes back as change, and
+// that excess must be zero or at least minChange, since a smaller excess
+// cannot form a valid change output.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	total, fee, locked, minChange, err := fees.TotalTransactionADACost(p, 400,
+//		[]uint64{5_000_000},
+//		[]fees.OutputSize{{AddressBytes: 57}},
+//		nil,
+//	)
+func TotalTransactionADACost(p ProtocolParams, txSizeBytes uint64, sentAmounts []uint64, outputs []OutputSize, deposits []uint64) (total, fee, locked, minChange uint64, err error) {
+	if err = p.Validate(); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if len(sentAmounts) != len(outputs) {
+		return 0, 0, 0, 0, NewFeeError(fmt.Sprintf("got %d sent amounts but %d outputs", len(sentAmounts), len(outputs)))
+	}
+	for i, out := range outputs {
+		ok, minADA, err := IsAboveMinUTxO(p, sentAmounts[i], out)
+		if err != nil {
+			return 0, 0, 0, 0, err
+		}
+		if !ok {
+			return 0, 0, 0, 0, newMinUTxOError(ErrCodeBelowMinUTxO, ErrBelowMinUTxO, fmt.Sprintf("output %d: %d Lovelace is below minUTxO %d", i, sentAmounts[i], minADA))
+		}
+	}
+
+	if fee, err = minFee(p, txSizeBytes); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if locked, err = SumLovelace(sentAmounts); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	depositTotal, err := SumLovelace(deposits)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if total, err = SumLovelace([]uint64{fee, locked, depositTotal}); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	if minChange, err = MinUTxOADAOnly(p); err != nil {
+		return 0, 0, 0, 0, err
+	}
+	return total, fee, locked, minChange, nil
+}
+
+// maxSaneFeeMultiple is the largest multiple of the minimum fee that
+// ValidateFeeForSubmission accepts. A fee above it is almost certainly a
+// unit mix-up (ADA entered as Lovelace) or a bug.
+const maxSaneFeeMultiple = 5
+
+// FeeValidationResult is the outcome of ValidateFeeForSubmission.
+type FeeValidationResult struct {
+	// IsValid is true if every check passed.
+	IsValid bool
+
+	// ProposedFee is the fee that was checked.
+	ProposedFee uint64
+
+	// MinFee is the minimum fee for the transaction size, or zero if it
+	// could not be calculated.
+	MinFee uint64
+
+	// ErrorMessage describes the first failed check; empty when IsValid.
+	ErrorMessage string
+}
+
+// ValidateFeeForSubmission checks a proposed fee before submission: the
+// transaction size must be within MaxTxSize, and the fee must be at least
+// MinFee but no more than five times MinFee. The upper bound is a sanity
+// check that catches unit mix-ups rather than a ledger rule.
+//
+// It always returns a populated result and never panics; failures are
+// reported through IsValid and ErrorMessage.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	r := fees.ValidateFeeForSubmission(p, 175_000, 420)
+//	if !r.IsValid {
+//		log.Println(r.ErrorMessage)
+//	}
+func ValidateFeeForSubmission(p ProtocolParams, proposedFee, txSizeBytes uint64) FeeValidationResult {
+	r := FeeValidationResult{ProposedFee: proposedFee}
+	if err := p.Validate(); err != nil {
+		r.ErrorMessage = err.Error()
+		return r
+	}
+	required, err := minFee(p, txSizeBytes)
+	if err != nil {
+		r.ErrorMessage = err.Error()
+		return r
+	}
+	r.MinFee = required
+
+	switch {
+	case proposedFee < required:
+		r.ErrorMessage = fmt.Sprintf("fee %d is below the minimum fee %d", proposedFee, required)
+	case required <= math.MaxUint64/maxSaneFeeMultiple && proposedFee > required*maxSaneFeeMultiple:
+		r.ErrorMessage = fmt.Sprintf("fee %d is more than %d times the minimum fee %d", proposedFee, maxSaneFeeMultiple, required)
+	default:
+		r.IsValid = true
+	}
+	return r
+}
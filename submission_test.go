@@ -0,0 +1,157 @@
+package fees_test
+
+import (
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestIsTransactionViable(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+	minFee, _ := fees.MinFee(p, 400)
+
+	tests := []struct {
+		name         string
+		txSize       uint64
+		fee          uint64
+		lovelaces    []uint64
+		wantSizeOK   bool
+		wantFeeOK    bool
+		wantOutputs  []bool
+		wantNumErrs  int
+		wantCallErr  bool
+		outputsCount int
+	}{
+		{"all good", 400, minFee, []uint64{2_000_000, 1_500_000}, true, true, []bool{true, true}, 0, false, 2},
+		{"fee too low", 400, minFee - 1, []uint64{2_000_000, 2_000_000}, true, false, []bool{true, true}, 1, false, 2},
+		{"tx too large", 20_000, minFee, []uint64{2_000_000, 2_000_000}, false, false, []bool{true, true}, 1, false, 2},
+		{"everything wrong", 20_000, 0, []uint64{100, 100}, false, false, []bool{false, false}, 3, false, 2},
+		{"mismatched slices", 400, minFee, []uint64{2_000_000}, false, false, nil, 0, true, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			outputs := make([]fees.OutputSize, tc.outputsCount)
+			for i := range outputs {
+				outputs[i] = out
+			}
+			r, err := fees.IsTransactionViable(p, tc.txSize, tc.fee, outputs, tc.lovelaces)
+			if tc.wantCallErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if r.SizeOK != tc.wantSizeOK || r.FeeOK != tc.wantFeeOK {
+				t.Errorf("SizeOK=%v FeeOK=%v, want %v %v", r.SizeOK, r.FeeOK, tc.wantSizeOK, tc.wantFeeOK)
+			}
+			for i, want := range tc.wantOutputs {
+				if r.OutputsOK[i] != want {
+					t.Errorf("OutputsOK[%d] = %v, want %v", i, r.OutputsOK[i], want)
+				}
+			}
+			if len(r.Errors) != tc.wantNumErrs {
+				t.Errorf("got %d errors %v, want %d", len(r.Errors), r.Errors, tc.wantNumErrs)
+			}
+			if r.Viable() != (tc.wantNumErrs == 0) {
+				t.Errorf("Viable() = %v", r.Viable())
+			}
+		})
+	}
+
+	if _, err := fees.IsTransactionViable(fees.ProtocolParams{}, 400, minFee, nil, nil); err == nil {
+		t.Error("expected error for zero params")
+	}
+}
+
+func TestTotalTransactionADACost(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+	wantFee, _ := fees.MinFee(p, 400)
+	wantMinChange, _ := fees.MinUTxOADAOnly(p)
+
+	total, fee, locked, minChange, err := fees.TotalTransactionADACost(p, 400,
+		[]uint64{5_000_000, 2_000_000},
+		[]fees.OutputSize{out, out},
+		[]uint64{2_000_000},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee != wantFee {
+		t.Errorf("fee = %d, want %d", fee, wantFee)
+	}
+	if locked != 7_000_000 {
+		t.Errorf("locked = %d, want 7000000", locked)
+	}
+	if total != wantFee+7_000_000+2_000_000 {
+		t.Errorf("total = %d, want %d", total, wantFee+9_000_000)
+	}
+	if minChange != wantMinChange {
+		t.Errorf("minChange = %d, want %d", minChange, wantMinChange)
+	}
+
+	tests := []struct {
+		name    string
+		p       fees.ProtocolParams
+		txSize  uint64
+		sent    []uint64
+		outputs []fees.OutputSize
+	}{
+		{"zero params", fees.ProtocolParams{}, 400, nil, nil},
+		{"mismatched slices", p, 400, []uint64{5_000_000}, nil},
+		{"below minUTxO", p, 400, []uint64{100}, []fees.OutputSize{out}},
+		{"tx too large", p, 20_000, nil, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, _, _, err := fees.TotalTransactionADACost(tc.p, tc.txSize, tc.sent, tc.outputs, nil); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestValidateFeeForSubmission(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	minFee, _ := fees.MinFee(p, 420)
+
+	tests := []struct {
+		name      string
+		p         fees.ProtocolParams
+		fee       uint64
+		txSize    uint64
+		wantValid bool
+		wantMin   uint64
+	}{
+		{"exact minimum", p, minFee, 420, true, minFee},
+		{"one below minimum", p, minFee - 1, 420, false, minFee},
+		{"five times minimum", p, 5 * minFee, 420, true, minFee},
+		{"ten times minimum", p, 10 * minFee, 420, false, minFee},
+		{"tx too large", p, minFee, 20_000, false, 0},
+		{"invalid params", fees.ProtocolParams{}, minFee, 420, false, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := fees.ValidateFeeForSubmission(tc.p, tc.fee, tc.txSize)
+			if r.IsValid != tc.wantValid {
+				t.Errorf("IsValid = %v, want %v (%s)", r.IsValid, tc.wantValid, r.ErrorMessage)
+			}
+			if r.ProposedFee != tc.fee {
+				t.Errorf("ProposedFee = %d, want %d", r.ProposedFee, tc.fee)
+			}
+			if r.MinFee != tc.wantMin {
+				t.Errorf("MinFee = %d, want %d", r.MinFee, tc.wantMin)
+			}
+			if r.IsValid != (r.ErrorMessage == "") {
+				t.Errorf("ErrorMessage %q inconsistent with IsValid %v", r.ErrorMessage, r.IsValid)
+			}
+		})
+	}
+}
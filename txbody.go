@@ -0,0 +1,209 @@
+package fees
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// TxBodyComponents describes the parts of a transaction body that the
+// size estimators model. Each input is key-witnessed.
+type TxBodyComponents struct {
+	// NumInputs is the number of key-witnessed inputs. Must be at least 1.
+	NumInputs uint64
+
+	// NumOutputs is the number of outputs. Must be at least 1.
+	NumOutputs uint64
+
+	// HasMetadata indicates whether the transaction carries auxiliary data.
+	// The body then carries the auxiliary_data_hash field.
+	HasMetadata bool
+
+	// MetadataBytes is the serialized size of the metadata, if known.
+	// When zero and HasMetadata is true, a 250-byte estimate is used. Only
+	// 35 bytes of it, the hash field, count toward the body; the rest is
+	// auxiliary data and counts only toward the fee.
+	MetadataBytes uint64
+
+	// IncludeNetworkID indicates that the body carries the optional
+	// network_id field.
+	IncludeNetworkID bool
+}
+
+// options returns the FeeEstimateOptions equivalent of c.
+func (c TxBodyComponents) options() FeeEstimateOptions {
+	return FeeEstimateOptions{
+		NumInputs:        c.NumInputs,
+		NumOutputs:       c.NumOutputs,
+		HasMetadata:      c.HasMetadata,
+		MetadataBytes:    c.MetadataBytes,
+		IncludeNetworkID: c.IncludeNetworkID,
+	}
+}
+
+// EstimateTxBodySize estimates the transaction body size, in bytes, for c.
+// It is EstimateTxBodyOnlyBytes for the equivalent FeeEstimateOptions.
+//
+// Returns a *ValidationError if c has no inputs or outputs, or sets
+// MetadataBytes without HasMetadata.
+//
+// Example:
+//
+//	size, err := fees.EstimateTxBodySize(fees.TxBodyComponents{NumInputs: 1, NumOutputs: 2})
+//	// 195 + 40 + 130 = 365
+func EstimateTxBodySize(c TxBodyComponents) (uint64, error) {
+	opts := c.options()
+	if err := opts.Validate(); err != nil {
+		return 0, err
+	}
+	return EstimateTxBodyOnlyBytes(opts), nil
+}
+
+// Byte estimates for the Conway transaction body fields.
+const (
+	// votingProceduresFieldBytes is the body key and map headers of the
+	// voting_procedures field.
+	votingProceduresFieldBytes uint64 = 3
+
+	// votingProcedureBytes is one vote: a voter credential (~31 bytes), a
+	// governance action ID (~36 bytes), and the vote with no anchor.
+	votingProcedureBytes uint64 = 75
+
+	// proposalProceduresFieldBytes is the body key and set header of the
+	// proposal_procedures field.
+	proposalProceduresFieldBytes uint64 = 3
+
+	// proposalProcedureBytes is one proposal: the deposit, a 29-byte
+	// return address, a simple governance action, and an anchor.
+	proposalProcedureBytes uint64 = 200
+
+	// treasuryCoinFieldBytes is the body key plus a coin of up to 9 bytes,
+	// used by both treasury_donation and current_treasury_value.
+	treasuryCoinFieldBytes uint64 = 10
+)
+
+// ConwayTxBodyComponents extends TxBodyComponents with the body fields
+// introduced in the Conway era.
+type ConwayTxBodyComponents struct {
+	TxBodyComponents
+
+	// NumVotingProcedures is the number of votes cast.
+	NumVotingProcedures uint64
+
+	// NumProposalProcedures is the number of governance actions proposed.
+	NumProposalProcedures uint64
+
+	// HasTreasuryDonation indicates the treasury_donation field is set.
+	HasTreasuryDonation bool
+
+	// HasCurrentTreasuryValue indicates the current_treasury_value field
+	// is set.
+	HasCurrentTreasuryValue bool
+}
+
+// EstimateConwayTxBodySize estimates the transaction body size, in bytes,
+// for c: EstimateTxBodySize(c.TxBodyComponents) plus
+//
+//	voting_procedures:      3 + 75 per vote      (if any)
+//	proposal_procedures:    3 + 200 per proposal (if any)
+//	treasury_donation:      10
+//	current_treasury_value: 10
+//
+// Proposals with large actions, such as parameter updates or constitution
+// changes, or long anchor URLs exceed the per-proposal estimate; measure
+// them instead.
+//
+// Returns a *ValidationError under the same conditions as
+// EstimateTxBodySize, or a *FeeError if the size overflows uint64.
+//
+// Example:
+//
+//	size, err := fees.EstimateConwayTxBodySize(fees.ConwayTxBodyComponents{
+//		TxBodyComponents:    fees.TxBodyComponents{NumInputs: 1, NumOutputs: 1},
+//		NumVotingProcedures: 2,
+//	})
+//	// 300 + 3 + 150 = 453
+func EstimateConwayTxBodySize(c ConwayTxBodyComponents) (uint64, error) {
+	size, err := EstimateTxBodySize(c.TxBodyComponents)
+	if err != nil {
+		return 0, err
+	}
+	if size, err = addRepeatedField(size, votingProceduresFieldBytes, c.NumVotingProcedures, votingProcedureBytes); err != nil {
+		return 0, err
+	}
+	if size, err = addRepeatedField(size, proposalProceduresFieldBytes, c.NumProposalProcedures, proposalProcedureBytes); err != nil {
+		return 0, err
+	}
+	if c.HasTreasuryDonation {
+		size += treasuryCoinFieldBytes
+	}
+	if c.HasCurrentTreasuryValue {
+		size += treasuryCoinFieldBytes
+	}
+	return size, nil
+}
+
+// addRepeatedField returns size plus a field of n items of bytesEach
+// bytes behind fieldBytes of framing, or size alone when n is zero. It
+// returns a *FeeError if the result overflows uint64.
+func addRepeatedField(size, fieldBytes, n, bytesEach uint64) (uint64, error) {
+	if n == 0 {
+		return size, nil
+	}
+	hi, items := bits.Mul64(n, bytesEach)
+	if hi != 0 {
+		return 0, NewFeeError(fmt.Sprintf("%d × %d bytes overflows uint64", n, bytesEach))
+	}
+	size, carry := bits.Add64(size, fieldBytes, 0)
+	size, carry2 := bits.Add64(size, items, 0)
+	if carry != 0 || carry2 != 0 {
+		return 0, NewFeeError("transaction size overflows uint64")
+	}
+	return size, nil
+}
+
+// MinFeeForConwayTx returns the minimum fee for a Conway transaction
+// described by c. The size is the EstimateConwayTxBodySize body, one VKey
+// witness per input and one per voting procedure (each voter signs), the
+// metadata if any, and the 5-byte outer array. Proposal deposits are not included; see
+// TotalCostForParamUpdate.
+//
+// Returns an error if p is invalid, c is invalid, or the estimate exceeds
+// MaxTxSize or overflows uint64.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.MinFeeForConwayTx(p, fees.ConwayTxBodyComponents{
+//		TxBodyComponents:    fees.TxBodyComponents{NumInputs: 1, NumOutputs: 1},
+//		NumVotingProcedures: 1,
+//	})
+func MinFeeForConwayTx(p ProtocolParams, c ConwayTxBodyComponents) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	return minFeeForConwayTx(p, c, c.NumVotingProcedures)
+}
+
+// minFeeForConwayTx is MinFeeForConwayTx without parameter validation,
+// with numVoters VKey witnesses for the voters instead of one per voting
+// procedure.
+func minFeeForConwayTx(p ProtocolParams, c ConwayTxBodyComponents, numVoters uint64) (uint64, error) {
+	body, err := EstimateConwayTxBodySize(c)
+	if err != nil {
+		return 0, err
+	}
+	opts := c.options()
+	hi, voterWitnesses := bits.Mul64(vkeyWitnessBytes, numVoters)
+	if hi != 0 {
+		return 0, NewFeeError("voter witness bytes overflow uint64")
+	}
+	size, carry := bits.Add64(body, voterWitnesses, 0)
+	if carry != 0 {
+		return 0, NewFeeError("transaction size overflows uint64")
+	}
+	size, carry = bits.Add64(size, txEnvelopeBytes+EstimateTxWitnessOnlyBytes(opts)+EstimateTxAuxDataOnlyBytes(opts), 0)
+	if carry != 0 {
+		return 0, NewFeeError("transaction size overflows uint64")
+	}
+	return minFee(p, size)
+}
@@ -0,0 +1,122 @@
+package fees_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestEstimateTxBodySize(t *testing.T) {
+	got, err := fees.EstimateTxBodySize(fees.TxBodyComponents{NumInputs: 1, NumOutputs: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := fees.EstimateTxBodyOnlyBytes(fees.FeeEstimateOptions{NumInputs: 1, NumOutputs: 2}); got != want {
+		t.Errorf("EstimateTxBodySize = %d, want %d", got, want)
+	}
+
+	// Metadata is auxiliary data: the body only grows by its hash field.
+	withMeta, err := fees.EstimateTxBodySize(fees.TxBodyComponents{NumInputs: 1, NumOutputs: 2, HasMetadata: true, MetadataBytes: 600})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if withMeta != got+35 {
+		t.Errorf("EstimateTxBodySize with metadata = %d, want %d", withMeta, got+35)
+	}
+
+	var ve *fees.ValidationError
+	if _, err := fees.EstimateTxBodySize(fees.TxBodyComponents{NumOutputs: 1}); !errors.As(err, &ve) {
+		t.Errorf("err = %v, want *ValidationError", err)
+	}
+}
+
+func TestEstimateConwayTxBodySize(t *testing.T) {
+	base := fees.TxBodyComponents{NumInputs: 1, NumOutputs: 1} // 300 bytes
+
+	tests := []struct {
+		name string
+		c    fees.ConwayTxBodyComponents
+		want uint64
+	}{
+		{"no Conway fields", fees.ConwayTxBodyComponents{TxBodyComponents: base}, 300},
+		{"two votes", fees.ConwayTxBodyComponents{TxBodyComponents: base, NumVotingProcedures: 2}, 300 + 3 + 150},
+		{"one proposal", fees.ConwayTxBodyComponents{TxBodyComponents: base, NumProposalProcedures: 1}, 300 + 3 + 200},
+		{"treasury fields", fees.ConwayTxBodyComponents{TxBodyComponents: base, HasTreasuryDonation: true, HasCurrentTreasuryValue: true}, 320},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.EstimateConwayTxBodySize(tc.c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("EstimateConwayTxBodySize = %d, want %d", got, tc.want)
+			}
+		})
+	}
+
+	if _, err := fees.EstimateConwayTxBodySize(fees.ConwayTxBodyComponents{NumVotingProcedures: 1}); err == nil {
+		t.Error("expected error for a body with no inputs or outputs")
+	}
+
+	// 75 × this many votes wraps to 1,000 bytes without the overflow check.
+	var fe *fees.FeeError
+	wrapping := fees.ConwayTxBodyComponents{TxBodyComponents: base, NumVotingProcedures: 2_635_249_153_387_078_808}
+	if _, err := fees.EstimateConwayTxBodySize(wrapping); !errors.As(err, &fe) {
+		t.Errorf("err = %v, want *FeeError for overflowing votes", err)
+	}
+	wrapping = fees.ConwayTxBodyComponents{TxBodyComponents: base, NumProposalProcedures: math.MaxUint64 / 100}
+	if _, err := fees.EstimateConwayTxBodySize(wrapping); !errors.As(err, &fe) {
+		t.Errorf("err = %v, want *FeeError for overflowing proposals", err)
+	}
+}
+
+func TestMinFeeForConwayTx(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	base := fees.TxBodyComponents{NumInputs: 1, NumOutputs: 1}
+
+	plain, err := fees.MinFeeForConwayTx(p, fees.ConwayTxBodyComponents{TxBodyComponents: base})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.EstimateFee(p, 1, 1, false); plain != want {
+		t.Errorf("fee with no Conway fields = %d, want EstimateFee %d", plain, want)
+	}
+
+	vote, err := fees.MinFeeForConwayTx(p, fees.ConwayTxBodyComponents{TxBodyComponents: base, NumVotingProcedures: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := plain + p.MinFeeA*(3+75+100); vote != want {
+		t.Errorf("fee with one vote = %d, want %d", vote, want)
+	}
+
+	// Metadata is charged in the fee even though it is outside the body.
+	meta := base
+	meta.HasMetadata, meta.MetadataBytes = true, 600
+	withMeta, err := fees.MinFeeForConwayTx(p, fees.ConwayTxBodyComponents{TxBodyComponents: meta})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := plain + p.MinFeeA*600; withMeta != want {
+		t.Errorf("fee with metadata = %d, want %d", withMeta, want)
+	}
+
+	bad := p
+	bad.MinFeeA = 0
+	if _, err := fees.MinFeeForConwayTx(bad, fees.ConwayTxBodyComponents{TxBodyComponents: base}); err == nil {
+		t.Error("expected error for invalid params")
+	}
+
+	// The first count overflows the body; the second fits the body but not
+	// the 100-byte voter witnesses.
+	var fe *fees.FeeError
+	for _, votes := range []uint64{2_635_249_153_387_078_808, math.MaxUint64 / 80} {
+		if _, err := fees.MinFeeForConwayTx(p, fees.ConwayTxBodyComponents{TxBodyComponents: base, NumVotingProcedures: votes}); !errors.As(err, &fe) {
+			t.Errorf("%d votes: err = %v, want *FeeError", votes, err)
+		}
+	}
+}
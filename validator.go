@@ -0,0 +1,114 @@
+package fees
+
+import "fmt"
+
+// TxOutputCost pairs an output's structure with the Lovelace it holds.
+type TxOutputCost struct {
+	// Output describes the output for minUTxO purposes.
+	Output OutputSize
+
+	// Lovelace is the ADA amount the output holds.
+	Lovelace uint64
+}
+
+// TxOutputValidator decides whether an output holds enough Lovelace.
+// Applications differ in how much buffer they require above the ledger
+// minimum; implement this interface to plug in a custom rule.
+type TxOutputValidator interface {
+	// Validate returns nil if lovelace is acceptable for out under p.
+	Validate(p ProtocolParams, out OutputSize, lovelace uint64) error
+}
+
+// StrictMinUTxOValidator accepts any output holding at least its exact
+// minUTxO, as the ledger does.
+type StrictMinUTxOValidator struct{}
+
+// Validate implements TxOutputValidator. It returns a *MinUTxOError with
+// ErrCodeBelowMinUTxO if lovelace is below MinUTxO(p, out).
+func (StrictMinUTxOValidator) Validate(p ProtocolParams, out OutputSize, lovelace uint64) error {
+	ok, required, err := IsAboveMinUTxO(p, lovelace, out)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return newMinUTxOError(ErrCodeBelowMinUTxO, ErrBelowMinUTxO, fmt.Sprintf("%d Lovelace is below minUTxO %d", lovelace, required))
+	}
+	return nil
+}
+
+// RoundedMinUTxOValidator requires each output to hold its minUTxO rounded
+// up to the nearest whole ADA, for wallets that only show whole-ADA
+// amounts.
+type RoundedMinUTxOValidator struct{}
+
+// Validate implements TxOutputValidator. It returns a *MinUTxOError with
+// ErrCodeBelowMinUTxO if lovelace is below MinUTxO(p, out) rounded up to a
+// whole ADA.
+func (RoundedMinUTxOValidator) Validate(p ProtocolParams, out OutputSize, lovelace uint64) error {
+	required, err := MinUTxO(p, out)
+	if err != nil {
+		return err
+	}
+	if rem := required % LovelacePerADA; rem != 0 {
+		if required, err = AddLovelace(required, LovelacePerADA-rem); err != nil {
+			return err
+		}
+	}
+	if lovelace < required {
+		return newMinUTxOError(ErrCodeBelowMinUTxO, ErrBelowMinUTxO, fmt.Sprintf("%d Lovelace is below rounded minUTxO %d", lovelace, required))
+	}
+	return nil
+}
+
+// ValidateOutputLovelace checks that lovelace is a feasible ADA amount for
+// out: at least MinUTxO(p, out) and at most MaxSupplyLovelace. It returns
+// a *MinUTxOError with ErrCodeBelowMinUTxO or ErrCodeAboveMaxSupply, or
+// the error from MinUTxO.
+//
+// Example:
+//
+//	err := fees.ValidateOutputLovelace(p, 2_000_000, fees.OutputSize{AddressBytes: 57}) // nil
+func ValidateOutputLovelace(p ProtocolParams, lovelace uint64, out OutputSize) error {
+	if lovelace > MaxSupplyLovelace {
+		return newMinUTxOError(ErrCodeAboveMaxSupply, ErrAboveMaxSupply, fmt.Sprintf("%d Lovelace exceeds maximum supply %d", lovelace, MaxSupplyLovelace))
+	}
+	return StrictMinUTxOValidator{}.Validate(p, out, lovelace)
+}
+
+// ValidateOutputs applies ValidateOutputLovelace to each output and
+// returns a slice parallel to outputs: a nil entry means that output is
+// valid. Unlike ValidateAllOutputs it reports every failure, not just the
+// first.
+//
+// Example:
+//
+//	errs := fees.ValidateOutputs(p, outputs)
+//	for i, err := range errs {
+//		if err != nil {
+//			log.Printf("output %d: %v", i, err)
+//		}
+//	}
+func ValidateOutputs(p ProtocolParams, outputs []TxOutputCost) []error {
+	errs := make([]error, len(outputs))
+	for i, o := range outputs {
+		errs[i] = ValidateOutputLovelace(p, o.Lovelace, o.Output)
+	}
+	return errs
+}
+
+// ValidateAllOutputs checks every output with v and returns the first
+// failure, prefixed with the output's index, or nil if all pass.
+//
+// Example:
+//
+//	err := fees.ValidateAllOutputs(fees.StrictMinUTxOValidator{}, p, []fees.TxOutputCost{
+//		{Output: fees.OutputSize{AddressBytes: 57}, Lovelace: 2_000_000},
+//	})
+func ValidateAllOutputs(v TxOutputValidator, p ProtocolParams, outputs []TxOutputCost) error {
+	for i, o := range outputs {
+		if err := v.Validate(p, o.Output, o.Lovelace); err != nil {
+			return fmt.Errorf("output %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package fees_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestTxOutputValidators(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+	minADA, _ := fees.MinUTxO(p, out) // 1,017,160
+
+	tests := []struct {
+		name     string
+		v        fees.TxOutputValidator
+		lovelace uint64
+		wantErr  bool
+	}{
+		{"strict exact", fees.StrictMinUTxOValidator{}, minADA, false},
+		{"strict below", fees.StrictMinUTxOValidator{}, minADA - 1, true},
+		{"rounded exact minimum", fees.RoundedMinUTxOValidator{}, minADA, true},
+		{"rounded whole ADA", fees.RoundedMinUTxOValidator{}, 2_000_000, false},
+		{"rounded just below", fees.RoundedMinUTxOValidator{}, 1_999_999, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.v.Validate(p, out, tc.lovelace)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil && !errors.Is(err, fees.ErrBelowMinUTxO) {
+				t.Errorf("err = %v, want ErrBelowMinUTxO", err)
+			}
+		})
+	}
+}
+
+func TestValidateAllOutputs(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	outputs := []fees.TxOutputCost{
+		{Output: fees.OutputSize{AddressBytes: 57}, Lovelace: 2_000_000},
+		{Output: fees.OutputSize{AddressBytes: 57, NumPolicies: 1, NumAssets: 1, TotalAssetNameBytes: 32}, Lovelace: 1_000_000},
+	}
+
+	err := fees.ValidateAllOutputs(fees.StrictMinUTxOValidator{}, p, outputs)
+	if !errors.Is(err, fees.ErrBelowMinUTxO) || !strings.HasPrefix(err.Error(), "output 1:") {
+		t.Errorf("err = %v, want output 1 below minUTxO", err)
+	}
+	if err := fees.ValidateAllOutputs(fees.StrictMinUTxOValidator{}, p, outputs[:1]); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := fees.ValidateAllOutputs(fees.RoundedMinUTxOValidator{}, p, nil); err != nil {
+		t.Errorf("no outputs should pass, got %v", err)
+	}
+}
+
+func TestValidateOutputLovelace(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+	minADA, _ := fees.MinUTxO(p, out)
+
+	tests := []struct {
+		name     string
+		lovelace uint64
+		want     error
+	}{
+		{"at minimum", minADA, nil},
+		{"below minimum", minADA - 1, fees.ErrBelowMinUTxO},
+		{"at max supply", fees.MaxSupplyLovelace, nil},
+		{"above max supply", fees.MaxSupplyLovelace + 1, fees.ErrAboveMaxSupply},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := fees.ValidateOutputLovelace(p, tc.lovelace, out)
+			if tc.want == nil {
+				if err != nil {
+					t.Errorf("err = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.want) {
+				t.Errorf("err = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateOutputs(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	out := fees.OutputSize{AddressBytes: 57}
+	errs := fees.ValidateOutputs(p, []fees.TxOutputCost{
+		{Output: out, Lovelace: 2_000_000},
+		{Output: out, Lovelace: 1},
+		{Output: out, Lovelace: fees.MaxSupplyLovelace + 1},
+	})
+	if len(errs) != 3 {
+		t.Fatalf("len(errs) = %d, want 3", len(errs))
+	}
+	if errs[0] != nil {
+		t.Errorf("errs[0] = %v, want nil", errs[0])
+	}
+	if !errors.Is(errs[1], fees.ErrBelowMinUTxO) {
+		t.Errorf("errs[1] = %v, want ErrBelowMinUTxO", errs[1])
+	}
+	if !errors.Is(errs[2], &fees.MinUTxOError{Code: fees.ErrCodeAboveMaxSupply}) {
+		t.Errorf("errs[2] = %v, want ErrCodeAboveMaxSupply", errs[2])
+	}
+}
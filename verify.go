@@ -0,0 +1,109 @@
+package fees
+
+import "fmt"
+
+// VerifyFeeFormula checks that the fee functions behave as the linear fee
+// formula requires for p, as a self-test during deployment validation:
+// fees strictly increase with size, each extra byte costs exactly MinFeeA,
+// and MinFee(p, 1) is MinFeeA + MinFeeB, up to MaxTxSize.
+//
+// Returns the first violated invariant as a *FeeError, or any error from
+// validating p.
+//
+// Example:
+//
+//	if err := fees.VerifyFeeFormula(p); err != nil {
+//		log.Fatalf("fee self-test failed: %v", err)
+//	}
+func VerifyFeeFormula(p ProtocolParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	sizes := []uint64{1, 200, 1_000, p.MaxTxSize / 2, p.MaxTxSize - 1}
+	for _, n := range sizes {
+		if n == 0 || n+1 > p.MaxTxSize {
+			continue // probe does not fit a small MaxTxSize
+		}
+		lo, err := minFee(p, n)
+		if err != nil {
+			return err
+		}
+		hi, err := minFee(p, n+1)
+		if err != nil {
+			return err
+		}
+		if lo >= hi {
+			return NewFeeError(fmt.Sprintf("invariant violated: MinFee(%d) = %d is not less than MinFee(%d) = %d", n, lo, n+1, hi))
+		}
+		if hi-lo != p.MinFeeA {
+			return NewFeeError(fmt.Sprintf("invariant violated: MinFee(%d) - MinFee(%d) = %d, want MinFeeA %d", n+1, n, hi-lo, p.MinFeeA))
+		}
+	}
+
+	one, err := minFee(p, 1)
+	if err != nil {
+		return err
+	}
+	if one != p.MinFeeA+p.MinFeeB {
+		return NewFeeError(fmt.Sprintf("invariant violated: MinFee(1) = %d, want MinFeeA + MinFeeB = %d", one, p.MinFeeA+p.MinFeeB))
+	}
+	return nil
+}
+
+// VerifyMinUTxOFormula checks that the minUTxO functions behave as the
+// CIP-55 formula requires for p: minUTxO strictly increases with output
+// size, matches (160 + bytes) * CoinsPerUTxOByte, agrees with
+// MinUTxOFromComponents, and is higher for an NFT output than for an
+// ADA-only one.
+//
+// Returns the first violated invariant as a *MinUTxOError, or any error
+// from validating p.
+//
+// Example:
+//
+//	if err := fees.VerifyMinUTxOFormula(p); err != nil {
+//		log.Fatalf("minUTxO self-test failed: %v", err)
+//	}
+func VerifyMinUTxOFormula(p ProtocolParams) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	for _, n := range []uint64{1, 65, 76, 500, 5_000} {
+		lo, err := MinUTxOFromBytes(p, n)
+		if err != nil {
+			return err
+		}
+		hi, err := MinUTxOFromBytes(p, n+1)
+		if err != nil {
+			return err
+		}
+		if lo >= hi {
+			return NewMinUTxOError(fmt.Sprintf("invariant violated: MinUTxOFromBytes(%d) = %d is not less than MinUTxOFromBytes(%d) = %d", n, lo, n+1, hi))
+		}
+		if want := (utxoEntryOverheadBytes + n) * p.CoinsPerUTxOByte; lo != want {
+			return NewMinUTxOError(fmt.Sprintf("invariant violated: MinUTxOFromBytes(%d) = %d, want %d", n, lo, want))
+		}
+		components, err := MinUTxOFromComponents(p, n)
+		if err != nil {
+			return err
+		}
+		if components != lo {
+			return NewMinUTxOError(fmt.Sprintf("invariant violated: MinUTxOFromComponents(%d) = %d, want %d", n, components, lo))
+		}
+	}
+
+	adaOnly, err := MinUTxOADAOnly(p)
+	if err != nil {
+		return err
+	}
+	nft, err := MinUTxOForNFT(p, 32)
+	if err != nil {
+		return err
+	}
+	if nft <= adaOnly {
+		return NewMinUTxOError(fmt.Sprintf("invariant violated: NFT minUTxO %d is not above ADA-only minUTxO %d", nft, adaOnly))
+	}
+	return nil
+}
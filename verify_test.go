@@ -0,0 +1,45 @@
+package fees_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestVerifyFormulas(t *testing.T) {
+	for name, p := range fees.AllDefaultParams() {
+		t.Run(name, func(t *testing.T) {
+			if err := fees.VerifyFeeFormula(p); err != nil {
+				t.Errorf("VerifyFeeFormula: %v", err)
+			}
+			if err := fees.VerifyMinUTxOFormula(p); err != nil {
+				t.Errorf("VerifyMinUTxOFormula: %v", err)
+			}
+		})
+	}
+
+	if err := fees.VerifyFeeFormula(fees.ProtocolParams{}); !errors.Is(err, fees.ErrInvalidMinFeeA) {
+		t.Errorf("VerifyFeeFormula(zero params) = %v, want ErrInvalidMinFeeA", err)
+	}
+	if err := fees.VerifyMinUTxOFormula(fees.ProtocolParams{}); err == nil {
+		t.Error("VerifyMinUTxOFormula(zero params) should fail")
+	}
+
+	// The fixed probe sizes are skipped when MaxTxSize is smaller.
+	for _, maxTxSize := range []uint64{1, 2, 500} {
+		small := fees.DefaultMainnetParams()
+		small.MaxTxSize = maxTxSize
+		if err := fees.VerifyFeeFormula(small); err != nil {
+			t.Errorf("VerifyFeeFormula(MaxTxSize %d) = %v, want nil", maxTxSize, err)
+		}
+	}
+
+	// Params whose maximum fee overflows uint64 fail the self-test.
+	huge := fees.DefaultMainnetParams()
+	huge.MinFeeA = math.MaxUint64 / 1_000
+	if err := fees.VerifyFeeFormula(huge); err == nil {
+		t.Error("VerifyFeeFormula should fail when fees overflow")
+	}
+}
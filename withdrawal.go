@@ -0,0 +1,133 @@
+package fees
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Byte estimates for reward withdrawals.
+const (
+	// withdrawalsFieldBytes is the body key and map header of the
+	// withdrawals field.
+	withdrawalsFieldBytes uint64 = 3
+
+	// withdrawalRedeemerBytes is a Plutus redeemer with a small data
+	// argument and its ExUnits.
+	withdrawalRedeemerBytes uint64 = 20
+)
+
+// WithdrawalByteEstimate returns the approximate CBOR size, in bytes, of
+// one entry in the withdrawals map: a 29-byte reward address with its CBOR
+// header and a coin amount of up to 9 bytes. 40 bytes.
+//
+// Example:
+//
+//	fees.WithdrawalByteEstimate() // 40
+func WithdrawalByteEstimate() uint64 {
+	return 40
+}
+
+// WithdrawalFee estimates the fee for a transaction that withdraws rewards
+// from numWithdrawals key-based reward accounts, spending numInputs inputs
+// to numOutputs outputs. Each withdrawal is signed by its stake key:
+//
+//	size = 200 + 140*numInputs + 65*numOutputs
+//	     + 3 + (40 + 100)*numWithdrawals
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.WithdrawalFee(p, 1, 1, 1)
+//	// size = 548 bytes
+func WithdrawalFee(p ProtocolParams, numWithdrawals, numInputs, numOutputs uint64) (uint64, error) {
+	return withdrawalFee(p, numWithdrawals, numInputs, numOutputs, vkeyWitnessBytes, 0)
+}
+
+// ScriptWithdrawalFee estimates the fee for a transaction that withdraws
+// rewards from numWithdrawals Plutus script-based reward accounts. Each
+// withdrawal is witnessed by its script, scriptBytes long, and a redeemer
+// instead of a stake key, and the body carries the script_data_hash field:
+//
+//	size = 200 + 140*numInputs + 65*numOutputs
+//	     + 3 + (40 + scriptBytes + 20)*numWithdrawals + 34
+//
+// Pass zero scriptBytes for a script supplied by reference; the size then
+// includes one reference input (3 + 40 bytes) instead of the script. The
+// Conway surcharge for the referenced script is not included; add
+// RefScriptFee for its size, or use RefScriptTransactionFee. The script
+// execution cost is not included either; add ScriptFee for the redeemers'
+// ExUnits.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	fee, err := fees.ScriptWithdrawalFee(p, 1, 2_000, 1, 1)
+func ScriptWithdrawalFee(p ProtocolParams, numWithdrawals uint64, scriptBytes uint64, numInputs, numOutputs uint64) (uint64, error) {
+	if scriptBytes > math.MaxUint64-withdrawalRedeemerBytes {
+		return 0, NewFeeError("witness bytes overflow uint64")
+	}
+	extraBytes := ScriptDataHashBytes()
+	if scriptBytes == 0 {
+		extraBytes += refInputFieldBytes + txInBytes
+	}
+	return withdrawalFee(p, numWithdrawals, numInputs, numOutputs, scriptBytes+withdrawalRedeemerBytes, extraBytes)
+}
+
+// MinUTxOForRewardOutput returns the minimum Lovelace for the ADA-only
+// output that receives withdrawn staking rewards at an address of type
+// addrType. Rewards are withdrawn into the transaction's balance and must
+// be sent on in an output that meets its own minUTxO, so a reward smaller
+// than this cannot be withdrawn into a fresh output on its own; it has to
+// be combined with other inputs. It is MinUTxOForAddressType under a name
+// that says what it is for.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	minADA, err := fees.MinUTxOForRewardOutput(p, fees.AddressBase)
+func MinUTxOForRewardOutput(p ProtocolParams, addrType AddressType) (uint64, error) {
+	return MinUTxOForAddressType(p, addrType)
+}
+
+// CanWithdrawReward reports whether a reward of rewardAmount Lovelace is
+// enough to fund, alone, the output it is withdrawn into, and returns that
+// output's minUTxO. The transaction fee is not considered.
+//
+// Example:
+//
+//	p := fees.DefaultMainnetParams()
+//	ok, minADA, err := fees.CanWithdrawReward(p, 1_500_000, fees.AddressBase)
+func CanWithdrawReward(p ProtocolParams, rewardAmount uint64, addrType AddressType) (bool, uint64, error) {
+	minADA, err := MinUTxOForRewardOutput(p, addrType)
+	if err != nil {
+		return false, 0, err
+	}
+	return rewardAmount >= minADA, minADA, nil
+}
+
+// withdrawalFee is the shared implementation of WithdrawalFee and
+// ScriptWithdrawalFee: witnessBytes are added per withdrawal and
+// extraBodyBytes once.
+func withdrawalFee(p ProtocolParams, numWithdrawals, numInputs, numOutputs, witnessBytes, extraBodyBytes uint64) (uint64, error) {
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+	if numWithdrawals == 0 {
+		return 0, NewFeeError("numWithdrawals must be at least 1")
+	}
+	if numInputs == 0 {
+		return 0, NewFeeError("numInputs must be at least 1")
+	}
+	if numOutputs == 0 {
+		return 0, NewFeeError("numOutputs must be at least 1")
+	}
+	hi, withdrawals := bits.Mul64(numWithdrawals, WithdrawalByteEstimate()+witnessBytes)
+	if hi != 0 || witnessBytes > math.MaxUint64-WithdrawalByteEstimate() {
+		return 0, NewFeeError("witness bytes overflow uint64")
+	}
+	size, carry := bits.Add64(structuralTxSize(numInputs, numOutputs, false)+withdrawalsFieldBytes+extraBodyBytes, withdrawals, 0)
+	if carry != 0 {
+		return 0, NewFeeError("transaction size overflows uint64")
+	}
+	return minFee(p, size)
+}
@@ -0,0 +1,115 @@
+package fees_test
+
+import (
+	"math"
+	"testing"
+
+	fees "github.com/njchilds90/go-cardano-fees"
+)
+
+func TestWithdrawalFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	tests := []struct {
+		name        string
+		withdrawals uint64
+		inputs      uint64
+		outputs     uint64
+		wantSize    uint64
+	}{
+		{"one", 1, 1, 1, 548},
+		{"two accounts", 2, 1, 2, 200 + 140 + 130 + 3 + 280},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fees.WithdrawalFee(p, tc.withdrawals, tc.inputs, tc.outputs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want, _ := fees.MinFee(p, tc.wantSize); got != want {
+				t.Errorf("WithdrawalFee = %d, want MinFee(%d) = %d", got, tc.wantSize, want)
+			}
+		})
+	}
+}
+
+func TestScriptWithdrawalFee(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+
+	got, err := fees.ScriptWithdrawalFee(p, 1, 2_000, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinFee(p, 200+140+65+3+40+2_000+20+34); got != want {
+		t.Errorf("ScriptWithdrawalFee = %d, want %d", got, want)
+	}
+
+	// A script supplied by reference adds a reference input instead of
+	// its bytes; its Conway surcharge is priced separately.
+	byRef, err := fees.ScriptWithdrawalFee(p, 1, 0, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinFee(p, 200+140+65+3+40+20+34+3+40); byRef != want {
+		t.Errorf("ScriptWithdrawalFee by reference = %d, want %d", byRef, want)
+	}
+	refFee, _ := fees.RefScriptFee(p, 2_000)
+	if key, _ := fees.WithdrawalFee(p, 1, 1, 1); byRef+refFee <= key {
+		t.Errorf("withdrawal with a 2,000-byte reference script costs %d, not above key withdrawal fee %d", byRef+refFee, key)
+	}
+
+	for _, scriptBytes := range []uint64{500, 2_000, 8_000} {
+		key, _ := fees.WithdrawalFee(p, 1, 1, 1)
+		script, err := fees.ScriptWithdrawalFee(p, 1, scriptBytes, 1, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if script <= key {
+			t.Errorf("%d-byte script withdrawal fee %d is not above key withdrawal fee %d", scriptBytes, script, key)
+		}
+	}
+
+	if _, err := fees.ScriptWithdrawalFee(p, 2, math.MaxUint64/2, 1, 1); err == nil {
+		t.Error("expected error for overflowing script bytes")
+	}
+	if _, err := fees.ScriptWithdrawalFee(p, 1, math.MaxUint64, 1, 1); err == nil {
+		t.Error("expected error for script bytes overflowing with the redeemer")
+	}
+	if _, err := fees.WithdrawalFee(p, 0, 1, 1); err == nil {
+		t.Error("expected error for zero withdrawals")
+	}
+}
+
+func TestCanWithdrawReward(t *testing.T) {
+	p := fees.DefaultMainnetParams()
+	minADA, err := fees.MinUTxOForRewardOutput(p, fees.AddressBase)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := fees.MinUTxOForAddressType(p, fees.AddressBase); minADA != want {
+		t.Errorf("MinUTxOForRewardOutput = %d, want %d", minADA, want)
+	}
+
+	tests := []struct {
+		name   string
+		reward uint64
+		want   bool
+	}{
+		{"below", minADA - 1, false},
+		{"exact", minADA, true},
+		{"above", minADA + 1, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, required, err := fees.CanWithdrawReward(p, tc.reward, fees.AddressBase)
+			if err != nil || ok != tc.want || required != minADA {
+				t.Errorf("CanWithdrawReward(%d) = %v, %d, %v; want %v, %d, nil", tc.reward, ok, required, err, tc.want, minADA)
+			}
+		})
+	}
+
+	if _, _, err := fees.CanWithdrawReward(p, minADA, fees.AddressType(99)); err == nil {
+		t.Error("expected error for unknown address type")
+	}
+}